@@ -1,14 +1,33 @@
 package main
 
 import (
+    "errors"
     "fmt"
     "io/ioutil"
     "os"
+    "strconv"
     "strings"
+    "time"
 
     "github.com/RobertP-SyndicateLabs/SIC-lang/compiler"
 )
 
+// Exit codes, so scripts and CI can tell failures apart:
+//
+//   0  success
+//   1  usage error or unreadable file
+//   2  parse error (the scroll never ran)
+//   3  runtime error (the scroll failed while running)
+//   4  lint findings (`sic lint` only)
+//
+// A scroll that stops with HALT WITH CODE <n> exits with n instead.
+const (
+    exitUsage   = 1
+    exitParse   = 2
+    exitRuntime = 3
+    exitLint    = 4
+)
+
 func findCommand(args []string) (string, int) {
     // Skip path-like arguments: /something/something OR ./something
     for i, a := range args {
@@ -23,13 +42,13 @@ func findCommand(args []string) (string, int) {
 func main() {
     if len(os.Args) < 2 {
         fmt.Println("usage: sic <command> [args]")
-        os.Exit(1)
+        os.Exit(exitUsage)
     }
 
     cmd, idx := findCommand(os.Args[1:])
     if idx == -1 {
         fmt.Println("no valid command found")
-        os.Exit(1)
+        os.Exit(exitUsage)
     }
 
     // real arguments start AFTER the command
@@ -48,9 +67,11 @@ func main() {
         doLex(args)
     case "parse":
         doParse(args)
+    case "lint":
+        doLint(args)
     default:
         fmt.Println("unknown command:", cmd)
-        os.Exit(1)
+        os.Exit(exitUsage)
     }
 }
 
@@ -59,16 +80,69 @@ func doBuild(args []string) {
 }
 
 func doRun(args []string) {
+    var config map[string]string
+    for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+        switch args[0] {
+        case "--debug":
+            compiler.SetDebug(true)
+        case "--strict-coercion":
+            compiler.SetStrictCoercion(true)
+        case "--serial-concurrency":
+            compiler.SetSerialConcurrency(true)
+        case "--fake-clock":
+            // Start a clock at the given Unix time that only SLEEP moves.
+            if len(args) < 2 {
+                fmt.Println("usage: --fake-clock <unix-seconds>")
+                os.Exit(exitUsage)
+            }
+            secs, err := strconv.ParseInt(args[1], 10, 64)
+            if err != nil {
+                fmt.Println("usage: --fake-clock <unix-seconds>")
+                os.Exit(exitUsage)
+            }
+            compiler.SetClock(compiler.NewFakeClock(time.Unix(secs, 0)))
+            args = args[1:]
+        case "--config":
+            if len(args) < 2 {
+                fmt.Println("usage: --config <config.json>")
+                os.Exit(exitUsage)
+            }
+            var err error
+            config, err = compiler.LoadConfigSigils(args[1])
+            if err != nil {
+                fmt.Fprintln(os.Stderr, "[SIC] error:", err)
+                os.Exit(exitUsage)
+            }
+            args = args[1:]
+        default:
+            fmt.Println("unknown run flag:", args[0])
+            os.Exit(exitUsage)
+        }
+        args = args[1:]
+    }
+
     if len(args) == 0 {
-        fmt.Println("usage: sic run <file.sic>")
-        os.Exit(1)
+        fmt.Println("usage: sic run [--debug] [--strict-coercion] [--serial-concurrency] [--fake-clock unix-seconds] [--config config.json] <file.sic | -> [args...]")
+        os.Exit(exitUsage)
     }
 
     filename := args[0]
 
-    if err := compiler.RunFile(filename); err != nil {
-        fmt.Fprintln(os.Stderr, "[SIC] runtime error:", err)
-        os.Exit(1)
+    if err := compiler.RunFileWith(filename, args[1:], config); err != nil {
+        var halt *compiler.ExitError
+        switch {
+        case errors.As(err, &halt):
+            os.Exit(halt.Code)
+        case errors.Is(err, compiler.ErrReadFailed):
+            fmt.Fprintln(os.Stderr, "[SIC] error:", err)
+            os.Exit(exitUsage)
+        case errors.Is(err, compiler.ErrParseFailed):
+            fmt.Fprintln(os.Stderr, "[SIC] parse error:", err)
+            os.Exit(exitParse)
+        default:
+            fmt.Fprintln(os.Stderr, "[SIC] runtime error:", err)
+            os.Exit(exitRuntime)
+        }
     }
 }
 
@@ -77,51 +151,201 @@ func doFmt(args []string) {
 }
 
 func doAnalyze(args []string) {
-    fmt.Println("[SIC] analyze is not implemented yet.")
+    if len(args) == 0 {
+        fmt.Println("usage: sic analyze <file.sic>")
+        os.Exit(exitUsage)
+    }
+
+    filename := args[0]
+    data, err := ioutil.ReadFile(filename)
+    if err != nil {
+        fmt.Println("error reading file:", err)
+        os.Exit(exitUsage)
+    }
+
+    // Keep comments so a comment block above a WORK counts as its doc.
+    lx := compiler.NewLexer(string(data), filename)
+    lx.SetEmitComments(true)
+    p := compiler.NewParser(lx)
+    prog := p.ParseProgram()
+
+    if errs := p.Errors(); len(errs) > 0 {
+        fmt.Println("Parser reported errors:")
+        for _, d := range p.Diagnostics() {
+            fmt.Println("  -", d)
+        }
+        os.Exit(exitParse)
+    }
+
+    fmt.Println("== SIC ANALYZE ==")
+    fmt.Println("Scroll:", prog.Scroll)
+    fmt.Println("Works:")
+    for _, w := range prog.Works {
+        fmt.Printf("  - %s(%s)\n", w.Name, strings.Join(w.SigilParams, ", "))
+        if w.Doc == "" {
+            fmt.Println("      (no doc)")
+            continue
+        }
+        for _, line := range strings.Split(w.Doc, "\n") {
+            fmt.Println("      " + line)
+        }
+    }
 }
 
 func doLex(args []string) {
+    emitComments := false
+    hashComments := true
+    showOffsets := false
+    for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+        switch args[0] {
+        case "--comments":
+            emitComments = true
+        case "--offsets":
+            showOffsets = true
+        case "--no-hash-comments":
+            hashComments = false
+        default:
+            fmt.Println("unknown lex flag:", args[0])
+            os.Exit(exitUsage)
+        }
+        args = args[1:]
+    }
+
     if len(args) == 0 {
-        fmt.Println("usage: sic lex <file.sic>")
-        os.Exit(1)
+        fmt.Println("usage: sic lex [--comments] [--no-hash-comments] [--offsets] <file.sic>")
+        os.Exit(exitUsage)
     }
 
     filename := args[0]
     data, err := ioutil.ReadFile(filename)
     if err != nil {
         fmt.Println("error reading file:", err)
-        os.Exit(1)
+        os.Exit(exitUsage)
     }
 
     src := string(data)
     lx := compiler.NewLexer(src, filename)
+    lx.SetEmitComments(emitComments)
+    lx.SetHashComments(hashComments)
 
     for {
         tok := lx.NextToken()
-        fmt.Printf("%-12s %-20q (%s:%d:%d)\n",
-            tok.Type, tok.Lexeme, tok.File, tok.Line, tok.Column)
+        if showOffsets {
+            // Byte span of the token's source text: src[Offset:End].
+            fmt.Printf("%-12s %-20q (%s:%d:%d) [%d,%d)\n",
+                tok.Type, tok.Lexeme, tok.File, tok.Line, tok.Column, tok.Offset, tok.End)
+        } else {
+            fmt.Printf("%-12s %-20q (%s:%d:%d)\n",
+                tok.Type, tok.Lexeme, tok.File, tok.Line, tok.Column)
+        }
 
         if tok.Type == compiler.TOK_EOF {
             break
         }
-        if tok.Type == compiler.TOK_ILLEGAL {
-            fmt.Println("ILLEGAL token encountered, stopping.")
-            break
+    }
+
+    // The lexer keeps going past ILLEGAL tokens; list every error at the end.
+    if errs := lx.Errors(); len(errs) > 0 {
+        fmt.Printf("%d lexer error(s):\n", len(errs))
+        for _, e := range errs {
+            fmt.Println("  -", e)
+        }
+        os.Exit(exitParse)
+    }
+}
+
+func doLint(args []string) {
+    opts := compiler.LintOptions{Rules: map[compiler.LintRule]bool{}}
+
+    for len(args) > 0 && strings.HasPrefix(args[0], "--") {
+        flag := strings.TrimPrefix(args[0], "--")
+        args = args[1:]
+
+        if flag == "all" {
+            for _, r := range compiler.AllLintRules {
+                opts.Rules[r] = true
+            }
+            continue
+        }
+        if flag == "max-depth" {
+            if len(args) == 0 {
+                fmt.Println("--max-depth needs a number")
+                os.Exit(exitUsage)
+            }
+            n, err := strconv.Atoi(args[0])
+            if err != nil || n < 1 {
+                fmt.Println("--max-depth needs a positive number, got", args[0])
+                os.Exit(exitUsage)
+            }
+            opts.MaxDepth = n
+            args = args[1:]
+            continue
+        }
+
+        known := false
+        for _, r := range compiler.AllLintRules {
+            if flag == string(r) {
+                opts.Rules[r] = true
+                known = true
+            }
+        }
+        if !known {
+            fmt.Println("unknown lint flag: --" + flag)
+            os.Exit(exitUsage)
+        }
+    }
+
+    if len(args) == 0 {
+        fmt.Println("usage: sic lint [--all] [--dots] [--bare-sigils] [--nesting] [--unreachable] [--magic-numbers] [--max-depth N] <file.sic>")
+        os.Exit(exitUsage)
+    }
+
+    // No rule flags: run every rule.
+    if len(opts.Rules) == 0 {
+        for _, r := range compiler.AllLintRules {
+            opts.Rules[r] = true
         }
     }
+
+    filename := args[0]
+    data, err := ioutil.ReadFile(filename)
+    if err != nil {
+        fmt.Println("error reading file:", err)
+        os.Exit(exitUsage)
+    }
+
+    lx := compiler.NewLexer(string(data), filename)
+    p := compiler.NewParser(lx)
+    prog := p.ParseProgram()
+
+    if errs := p.Errors(); len(errs) > 0 {
+        fmt.Println("Parser reported errors:")
+        for _, d := range p.Diagnostics() {
+            fmt.Println("  -", d)
+        }
+        os.Exit(exitParse)
+    }
+
+    diags := compiler.Lint(prog, opts)
+    for _, d := range diags {
+        fmt.Println(d)
+    }
+    if len(diags) > 0 {
+        os.Exit(exitLint)
+    }
 }
 
 func doParse(args []string) {
     if len(args) == 0 {
         fmt.Println("usage: sic parse <file.sic>")
-        os.Exit(1)
+        os.Exit(exitUsage)
     }
 
     filename := args[0]
     data, err := ioutil.ReadFile(filename)
     if err != nil {
         fmt.Println("error reading file:", err)
-        os.Exit(1)
+        os.Exit(exitUsage)
     }
 
     src := string(data)
@@ -131,10 +355,10 @@ func doParse(args []string) {
 
     if errs := p.Errors(); len(errs) > 0 {
         fmt.Println("Parser reported errors:")
-        for _, e := range errs {
-            fmt.Println("  -", e)
+        for _, d := range p.Diagnostics() {
+            fmt.Println("  -", d)
         }
-        os.Exit(1)
+        os.Exit(exitParse)
     }
 
     fmt.Println("== SIC PROGRAM ==")
@@ -0,0 +1,562 @@
+package compiler
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ---- Expression builtins ----
+//
+// Builtins are function-style calls inside expressions:
+//
+//	LENGTH(UPPER(name)) + 1 > 3
+//
+// A call is a primary: parsePrimary parses NAME(arg, arg, ...) with each
+// argument evaluated through parseOr, then hands the result back to the
+// normal precedence climb. The result is tainted if any argument is.
+
+type builtinFunc func(args []exprValue) (exprValue, error)
+
+var exprBuiltins map[string]builtinFunc
+
+func init() {
+	exprBuiltins = map[string]builtinFunc{
+		"UPPER":  builtinUpper,
+		"LOWER":  builtinLower,
+		"TRIM":   builtinTrim,
+		"LENGTH": builtinLength,
+		"MIN":    builtinMin,
+		"MAX":    builtinMax,
+
+		"TYPE_OF": builtinTypeOf,
+
+		"CHAR_AT":   builtinCharAt,
+		"SUBSTRING": builtinSubstring,
+		"ITEM":      builtinItem,
+
+		"HTML_ESCAPE":   builtinHTMLEscape,
+		"FORMAT_NUMBER": builtinFormatNumber,
+
+		"COUNTERS": builtinCounters,
+
+		"UUID":   builtinUUID,
+		"ULID":   builtinULID,
+		"NANOID": builtinNanoID,
+	}
+}
+
+// lookupBuiltin returns the builtin for name (case-insensitive).
+func lookupBuiltin(name string) (builtinFunc, bool) {
+	fn, ok := exprBuiltins[strings.ToUpper(name)]
+	return fn, ok
+}
+
+// isBuiltinCall reports whether tokens[i] starts a builtin call: NAME(
+func isBuiltinCall(tokens []Token, i int) bool {
+	if i+1 >= len(tokens) || tokens[i+1].Type != TOK_LPAREN {
+		return false
+	}
+	_, ok := lookupBuiltin(tokens[i].Lexeme)
+	return ok
+}
+
+// parseBuiltinCall parses NAME(arg, ...) starting at tokens[*i] (the name).
+func parseBuiltinCall(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
+	nameTok := tokens[*i]
+	name := strings.ToUpper(nameTok.Lexeme)
+	fn, ok := lookupBuiltin(name)
+	if !ok {
+		return exprValue{}, fmt.Errorf("unknown builtin %s at %s:%d:%d",
+			nameTok.Lexeme, nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	*i += 2 // name + '('
+
+	var args []exprValue
+	if *i < len(tokens) && tokens[*i].Type == TOK_RPAREN {
+		*i++
+	} else {
+		for {
+			arg, err := parseOr(prog, tokens, i, sigils)
+			if err != nil {
+				return exprValue{}, err
+			}
+			args = append(args, arg)
+
+			if *i < len(tokens) && tokens[*i].Type == TOK_COMMA {
+				*i++
+				continue
+			}
+			if *i < len(tokens) && tokens[*i].Type == TOK_RPAREN {
+				*i++
+				break
+			}
+			return exprValue{}, fmt.Errorf("expected ',' or ')' in call to %s at %s:%d:%d",
+				name, nameTok.File, nameTok.Line, nameTok.Column)
+		}
+	}
+
+	out, err := fn(args)
+	if oe, ok := err.(*omenError); ok {
+		return exprValue{}, oe // catchable by IF OMEN, so not wrapped
+	}
+	if err != nil {
+		return exprValue{}, fmt.Errorf("%s: %v at %s:%d:%d",
+			name, err, nameTok.File, nameTok.Line, nameTok.Column)
+	}
+
+	tainted := false
+	for _, a := range args {
+		tainted = tainted || a.tainted
+	}
+	return withTaint(out, tainted), nil
+}
+
+// parseElapsedCall parses ELAPSED(name). The argument is a stopwatch
+// name (bare IDENT or STRING), not an expression, so it is handled
+// separately from the value builtins.
+func parseElapsedCall(tokens []Token, i *int) (exprValue, error) {
+	nameTok := tokens[*i]
+	*i += 2 // ELAPSED + '('
+
+	if *i >= len(tokens) || (tokens[*i].Type != TOK_IDENT && tokens[*i].Type != TOK_STRING) {
+		return exprValue{}, fmt.Errorf("ELAPSED: expected stopwatch name at %s:%d:%d",
+			nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	name := tokens[*i].Lexeme
+	*i++
+
+	if *i >= len(tokens) || tokens[*i].Type != TOK_RPAREN {
+		return exprValue{}, fmt.Errorf("ELAPSED: expected ')' at %s:%d:%d",
+			nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	*i++
+
+	secs, err := stopwatchElapsed(name)
+	if err != nil {
+		return exprValue{}, fmt.Errorf("ELAPSED: %v at %s:%d:%d",
+			err, nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	return makeFloat(secs), nil
+}
+
+// parseCountCall parses COUNT(name), the number of values behind a
+// multi-value sigil family: it reads <name>_COUNT (e.g. Q_TAG_COUNT) as
+// an int, or 0 if absent. Like ELAPSED, the argument is a name rather
+// than an expression; the result is tainted if the count sigil is.
+func parseCountCall(tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
+	nameTok := tokens[*i]
+	*i += 2 // COUNT + '('
+
+	if *i >= len(tokens) || (tokens[*i].Type != TOK_IDENT && tokens[*i].Type != TOK_STRING) {
+		return exprValue{}, fmt.Errorf("COUNT: expected sigil name at %s:%d:%d",
+			nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	key := tokens[*i].Lexeme + "_COUNT"
+	*i++
+
+	if *i >= len(tokens) || tokens[*i].Type != TOK_RPAREN {
+		return exprValue{}, fmt.Errorf("COUNT: expected ')' at %s:%d:%d",
+			nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	*i++
+
+	raw, ok := sigils[key]
+	if !ok {
+		return makeInt(0), nil
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+	if err != nil {
+		return exprValue{}, fmt.Errorf("COUNT: %s is not a count (%q) at %s:%d:%d",
+			key, raw, nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	return withTaint(makeInt(n), isInvisibleSigil(sigils, key)), nil
+}
+
+// parseCoalesceCall parses COALESCE(a, b, ...): the first argument whose
+// value is non-empty, else the last one. Arguments after the winner are
+// skipped without being evaluated, so a fallback SUMMON only runs when it
+// is needed. Only the chosen argument's taint carries over.
+func parseCoalesceCall(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
+	nameTok := tokens[*i]
+	*i += 2 // COALESCE + '('
+
+	if *i < len(tokens) && tokens[*i].Type == TOK_RPAREN {
+		return exprValue{}, fmt.Errorf("COALESCE: expected at least 1 argument at %s:%d:%d",
+			nameTok.File, nameTok.Line, nameTok.Column)
+	}
+
+	var out exprValue
+	found := false
+	for {
+		if found {
+			*i = skipCallArg(tokens, *i)
+		} else {
+			arg, err := parseOr(prog, tokens, i, sigils)
+			if err != nil {
+				return exprValue{}, err
+			}
+			out = arg
+			found = arg.String() != ""
+		}
+
+		if *i < len(tokens) && tokens[*i].Type == TOK_COMMA {
+			*i++
+			continue
+		}
+		if *i < len(tokens) && tokens[*i].Type == TOK_RPAREN {
+			*i++
+			return out, nil
+		}
+		return exprValue{}, fmt.Errorf("expected ',' or ')' in call to COALESCE at %s:%d:%d",
+			nameTok.File, nameTok.Line, nameTok.Column)
+	}
+}
+
+// skipCallArg returns the index just past one call argument starting at
+// i, without evaluating it: the next ',' or ')' outside parentheses.
+// Commas after a SUMMON ... WITH belong to the SUMMON, as in evalSummonExpr.
+func skipCallArg(tokens []Token, i int) int {
+	depth := 0
+	sawSummon, summonArgs := false, false
+	for ; i < len(tokens); i++ {
+		switch tokens[i].Type {
+		case TOK_LPAREN:
+			depth++
+		case TOK_RPAREN:
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case TOK_SUMMON:
+			if depth == 0 {
+				sawSummon = true
+			}
+		case TOK_WITH:
+			if depth == 0 && sawSummon {
+				summonArgs = true
+			}
+		case TOK_COMMA:
+			if depth == 0 && !summonArgs {
+				return i
+			}
+		}
+	}
+	return i
+}
+
+// parseQueryCall parses QUERY(key) or QUERY(key, default): the first
+// value of key in the current request's REQUEST_QUERY, parsed on demand.
+// Unlike the Q_<KEY> sigils the key is used verbatim, so "user-name" or
+// "filter[type]" can be read as written. A missing key (or no request)
+// yields default, or "". The result is tainted like REQUEST_QUERY.
+func parseQueryCall(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
+	nameTok := tokens[*i]
+	*i += 2 // QUERY + '('
+
+	var args []exprValue
+	for *i < len(tokens) && tokens[*i].Type != TOK_RPAREN {
+		arg, err := parseOr(prog, tokens, i, sigils)
+		if err != nil {
+			return exprValue{}, err
+		}
+		args = append(args, arg)
+		if *i < len(tokens) && tokens[*i].Type == TOK_COMMA {
+			*i++
+			continue
+		}
+		break
+	}
+	if *i >= len(tokens) || tokens[*i].Type != TOK_RPAREN {
+		return exprValue{}, fmt.Errorf("expected ',' or ')' in call to QUERY at %s:%d:%d",
+			nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	*i++ // ')'
+
+	if len(args) < 1 || len(args) > 2 {
+		return exprValue{}, fmt.Errorf("QUERY: expected 1 or 2 argument(s), got %d at %s:%d:%d",
+			len(args), nameTok.File, nameTok.Line, nameTok.Column)
+	}
+
+	fallback := makeText("")
+	if len(args) == 2 {
+		fallback = args[1]
+	}
+
+	raw, ok := sigils["REQUEST_QUERY"]
+	if !ok {
+		return fallback, nil
+	}
+	tainted := isInvisibleSigil(sigils, "REQUEST_QUERY") || args[0].tainted
+
+	// ParseQuery keeps every well-formed pair even when it reports an error.
+	q, _ := url.ParseQuery(raw)
+	vals, ok := q[args[0].String()]
+	if !ok || len(vals) == 0 {
+		return withTaint(fallback, fallback.tainted || tainted), nil
+	}
+	return withTaint(makeText(vals[0]), tainted), nil
+}
+
+func wantArgs(args []exprValue, n int) error {
+	if len(args) != n {
+		return fmt.Errorf("expected %d argument(s), got %d", n, len(args))
+	}
+	return nil
+}
+
+func builtinUpper(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 1); err != nil {
+		return exprValue{}, err
+	}
+	return makeText(strings.ToUpper(args[0].String())), nil
+}
+
+func builtinLower(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 1); err != nil {
+		return exprValue{}, err
+	}
+	return makeText(strings.ToLower(args[0].String())), nil
+}
+
+func builtinTrim(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 1); err != nil {
+		return exprValue{}, err
+	}
+	return makeText(strings.TrimSpace(args[0].String())), nil
+}
+
+func builtinLength(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 1); err != nil {
+		return exprValue{}, err
+	}
+	return makeInt(int64(len([]rune(args[0].String())))), nil
+}
+
+// builtinTypeOf names the kind its argument evaluated to: "text", "int",
+// "float" or "bool".
+func builtinTypeOf(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 1); err != nil {
+		return exprValue{}, err
+	}
+	return makeText(exprKindName(args[0].kind)), nil
+}
+
+func exprKindName(k exprKind) string {
+	switch k {
+	case exprInt:
+		return "int"
+	case exprFloat:
+		return "float"
+	case exprBool:
+		return "bool"
+	}
+	return "text"
+}
+
+// builtinCounters reads the COUNT EVENT registry: COUNTERS(name) is that
+// event's count (0 if it never fired) and COUNTERS() every count as a
+// JSON object.
+func builtinCounters(args []exprValue) (exprValue, error) {
+	switch len(args) {
+	case 0:
+		return makeText(eventCountersJSON()), nil
+	case 1:
+		return makeInt(eventCount(args[0].String())), nil
+	}
+	return exprValue{}, fmt.Errorf("expected 0 or 1 argument(s), got %d", len(args))
+}
+
+// ---- Indexed access: CHAR_AT, SUBSTRING, ITEM ----
+//
+// Indices count from 0; a negative index counts from the end (-1 is the
+// last element). An index outside the text or list raises OMEN
+// "index_error" instead of yielding an empty value.
+
+// resolveIndex maps idx (possibly negative) onto 0..n-1, or 0..n when
+// allowEnd is set (a slice end). ok is false when it is out of range.
+func resolveIndex(v exprValue, n int, allowEnd bool) (int, bool, error) {
+	f, ok := v.asFloat()
+	if !ok || f != math.Trunc(f) {
+		return 0, false, fmt.Errorf("index must be a whole number, got %q", v.String())
+	}
+	idx := int(f)
+	if idx < 0 {
+		idx += n
+	}
+	limit := n
+	if allowEnd {
+		limit = n + 1
+	}
+	return idx, idx >= 0 && idx < limit, nil
+}
+
+// builtinCharAt returns the character at an index of a text.
+func builtinCharAt(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 2); err != nil {
+		return exprValue{}, err
+	}
+	runes := []rune(args[0].String())
+	idx, ok, err := resolveIndex(args[1], len(runes), false)
+	if err != nil {
+		return exprValue{}, err
+	}
+	if !ok {
+		return exprValue{}, &omenError{name: "index_error"}
+	}
+	return makeText(string(runes[idx])), nil
+}
+
+// builtinSubstring returns text[start:end]; end defaults to the end of
+// the text and, like start, may be negative.
+func builtinSubstring(args []exprValue) (exprValue, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return exprValue{}, fmt.Errorf("expected 2 or 3 arguments, got %d", len(args))
+	}
+	runes := []rune(args[0].String())
+	start, ok, err := resolveIndex(args[1], len(runes), true)
+	if err != nil {
+		return exprValue{}, err
+	}
+	end := len(runes)
+	if len(args) == 3 {
+		var endOK bool
+		end, endOK, err = resolveIndex(args[2], len(runes), true)
+		if err != nil {
+			return exprValue{}, err
+		}
+		ok = ok && endOK
+	}
+	if !ok || start > end {
+		return exprValue{}, &omenError{name: "index_error"}
+	}
+	return makeText(string(runes[start:end])), nil
+}
+
+// builtinItem returns an element of a list value (a JSON array or
+// comma-separated text, as accepted by IN).
+func builtinItem(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 2); err != nil {
+		return exprValue{}, err
+	}
+	items := splitListValue(args[0].String())
+	idx, ok, err := resolveIndex(args[1], len(items), false)
+	if err != nil {
+		return exprValue{}, err
+	}
+	if !ok {
+		return exprValue{}, &omenError{name: "index_error"}
+	}
+	return makeText(items[idx]), nil
+}
+
+// builtinHTMLEscape escapes <, >, &, ' and " so untrusted text can be
+// interpolated into an HTML response.
+func builtinHTMLEscape(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 1); err != nil {
+		return exprValue{}, err
+	}
+	return makeText(html.EscapeString(args[0].String())), nil
+}
+
+// builtinMin returns the smallest numeric argument, keeping its kind.
+func builtinMin(args []exprValue) (exprValue, error) {
+	return pickNumeric(args, func(a, b float64) bool { return a < b })
+}
+
+// builtinMax returns the largest numeric argument, keeping its kind.
+func builtinMax(args []exprValue) (exprValue, error) {
+	return pickNumeric(args, func(a, b float64) bool { return a > b })
+}
+
+func pickNumeric(args []exprValue, better func(a, b float64) bool) (exprValue, error) {
+	if len(args) == 0 {
+		return exprValue{}, fmt.Errorf("expected at least 1 argument")
+	}
+	best := args[0]
+	bestF, ok := best.asFloat()
+	if !ok {
+		return exprValue{}, fmt.Errorf("non-numeric argument %q", best.String())
+	}
+	for _, a := range args[1:] {
+		f, ok := a.asFloat()
+		if !ok {
+			return exprValue{}, fmt.Errorf("non-numeric argument %q", a.String())
+		}
+		if better(f, bestF) {
+			best, bestF = a, f
+		}
+	}
+	return best, nil
+}
+
+// sicMaxFormatDecimals bounds FORMAT_NUMBER's decimals argument.
+const sicMaxFormatDecimals = 10
+
+// builtinFormatNumber implements FORMAT_NUMBER(value, decimals [, "group"]).
+// The value is rounded half away from zero (so 2.5 -> 3 and -2.5 -> -3)
+// and always shows exactly decimals places; "group" adds thousands
+// separators to the integer part. A result that rounds to zero never
+// carries a minus sign.
+func builtinFormatNumber(args []exprValue) (exprValue, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return exprValue{}, fmt.Errorf("expected 2 or 3 argument(s), got %d", len(args))
+	}
+	f, ok := args[0].asFloat()
+	if !ok || math.IsNaN(f) || math.IsInf(f, 0) {
+		return exprValue{}, fmt.Errorf("non-numeric argument %q", args[0].String())
+	}
+	d, ok := args[1].asFloat()
+	if !ok || d < 0 || d > sicMaxFormatDecimals || d != math.Trunc(d) {
+		return exprValue{}, fmt.Errorf("decimals must be an integer in 0..%d, got %q",
+			sicMaxFormatDecimals, args[1].String())
+	}
+	group := false
+	if len(args) == 3 {
+		if !strings.EqualFold(args[2].String(), "group") {
+			return exprValue{}, fmt.Errorf("unknown option %q (want \"group\")", args[2].String())
+		}
+		group = true
+	}
+	decimals := int(d)
+
+	scale := math.Pow(10, float64(decimals))
+	rounded := math.Round(math.Abs(f)*scale) / scale
+	s := strconv.FormatFloat(rounded, 'f', decimals, 64)
+
+	intPart, frac := s, ""
+	if dot := strings.IndexByte(s, '.'); dot >= 0 {
+		intPart, frac = s[:dot], s[dot:]
+	}
+	if group {
+		intPart = groupThousands(intPart)
+	}
+
+	sign := ""
+	if f < 0 && rounded != 0 {
+		sign = "-"
+	}
+	return makeText(sign + intPart + frac), nil
+}
+
+// groupThousands inserts ',' every three digits from the right.
+func groupThousands(digits string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	lead := len(digits) % 3
+	if lead > 0 {
+		b.WriteString(digits[:lead])
+	}
+	for n := lead; n < len(digits); n += 3 {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(digits[n : n+3])
+	}
+	return b.String()
+}
@@ -0,0 +1,88 @@
+package compiler
+
+import (
+	"sync"
+	"time"
+)
+
+// ---- Clock: every time read and wait goes through sicClock ----
+//
+// SLEEP, RETRY backoff, $TIME_NOW, STOPWATCH / ELAPSED and ULID() use
+// sicClock instead of calling time.Now / time.Sleep, so an embedder (or
+// `sic run --fake-clock`) can swap in a FakeClock and test them without
+// real waiting. CHOIR TIMEOUT stays on the wall clock: it guards against
+// hung tasks, which a fake clock would never notice.
+
+// Clock is the runtime's source of time.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+// realClock is the default Clock.
+type realClock struct{}
+
+func (realClock) Now() time.Time        { return time.Now() }
+func (realClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+var (
+	sicClockMu sync.RWMutex
+	sicClock   Clock = realClock{}
+)
+
+// SetClock replaces the runtime clock; nil restores the real one.
+func SetClock(c Clock) {
+	if c == nil {
+		c = realClock{}
+	}
+	sicClockMu.Lock()
+	sicClock = c
+	sicClockMu.Unlock()
+}
+
+func currentClock() Clock {
+	sicClockMu.RLock()
+	defer sicClockMu.RUnlock()
+	return sicClock
+}
+
+// sicNow reads the runtime clock.
+func sicNow() time.Time { return currentClock().Now() }
+
+// sicSleep waits on the runtime clock.
+func sicSleep(d time.Duration) { currentClock().Sleep(d) }
+
+// FakeClock is a Clock that only moves when told to. Sleep returns at
+// once after advancing the clock by d, so a scroll that SLEEPs 30 SECONDS
+// sees TIME_NOW move by 30 without waiting.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock returns a FakeClock reading start.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the fake time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Sleep advances the fake time by d without blocking.
+func (c *FakeClock) Sleep(d time.Duration) {
+	c.Advance(d)
+}
+
+// Advance moves the fake time forward by d (negative d is ignored).
+func (c *FakeClock) Advance(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
@@ -0,0 +1,126 @@
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ---- Config sigils: sic run --config config.json ----
+//
+//	{"greeting": "hello", "db": {"host": "localhost", "port": 5432}}
+//
+// seeds MAIN with the sigils greeting, db.host and db.port. Nested objects
+// flatten to dotted names, which expressions read with CONFIG("db.host").
+// Strings are taken as-is, numbers keep their JSON spelling, booleans
+// become "true"/"false", null becomes "" and arrays stay JSON text.
+
+// LoadConfigSigils reads a JSON object from path and flattens it into
+// initial sigils for RunFileWith.
+func LoadConfigSigils(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var root any
+	if err := dec.Decode(&root); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	obj, ok := root.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("config %s: top level must be a JSON object", path)
+	}
+
+	out := make(map[string]string)
+	if err := flattenConfig("", obj, out); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	return out, nil
+}
+
+// flattenConfig adds every leaf of obj to out under prefix + key.
+func flattenConfig(prefix string, obj map[string]any, out map[string]string) error {
+	for k, v := range obj {
+		if k == "" {
+			return fmt.Errorf("empty key under %q", prefix)
+		}
+		name := prefix + k
+
+		switch v := v.(type) {
+		case map[string]any:
+			if err := flattenConfig(name+".", v, out); err != nil {
+				return err
+			}
+		case string:
+			out[name] = v
+		case json.Number:
+			out[name] = v.String()
+		case bool:
+			if v {
+				out[name] = "true"
+			} else {
+				out[name] = "false"
+			}
+		case nil:
+			out[name] = ""
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("key %s: %w", name, err)
+			}
+			out[name] = string(b)
+		}
+	}
+	return nil
+}
+
+// parseConfigCall parses CONFIG(key) or CONFIG(key, default): the sigil
+// named key, looked up by its full name, so dotted config keys that an
+// expression cannot spell ("db.host") can be read. A missing key yields
+// default, or "".
+func parseConfigCall(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
+	nameTok := tokens[*i]
+	*i += 2 // CONFIG + '('
+
+	var args []exprValue
+	for *i < len(tokens) && tokens[*i].Type != TOK_RPAREN {
+		arg, err := parseOr(prog, tokens, i, sigils)
+		if err != nil {
+			return exprValue{}, err
+		}
+		args = append(args, arg)
+		if *i < len(tokens) && tokens[*i].Type == TOK_COMMA {
+			*i++
+			continue
+		}
+		break
+	}
+	if *i >= len(tokens) || tokens[*i].Type != TOK_RPAREN {
+		return exprValue{}, fmt.Errorf("expected ',' or ')' in call to CONFIG at %s:%d:%d",
+			nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	*i++ // ')'
+
+	if len(args) < 1 || len(args) > 2 {
+		return exprValue{}, fmt.Errorf("CONFIG: expected 1 or 2 argument(s), got %d at %s:%d:%d",
+			len(args), nameTok.File, nameTok.Line, nameTok.Column)
+	}
+
+	key := args[0].String()
+	val, ok := sigils[key]
+	if !ok {
+		if len(args) == 2 {
+			return args[1], nil
+		}
+		return makeText(""), nil
+	}
+	v, err := coerceSigilRead(prog, key, val, nameTok)
+	if err != nil {
+		return exprValue{}, err
+	}
+	return withTaint(v, args[0].tainted || isInvisibleSigil(sigils, key)), nil
+}
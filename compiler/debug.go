@@ -0,0 +1,113 @@
+package compiler
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// ---- Debugging: BREAK POINT. / INSPECT. ----
+//
+// With debugging on (sic run --debug), a breakpoint dumps the current
+// sigil table to stderr and, when stdin is a terminal, waits for Enter.
+// With debugging off it is a no-op, so breakpoints may stay in scrolls.
+
+var (
+	sicDebug   bool
+	sicDebugIn io.Reader = os.Stdin
+)
+
+// SetDebug turns BREAK POINT. / INSPECT. on or off.
+func SetDebug(on bool) {
+	sicDebug = on
+}
+
+// execInspect executes BREAK POINT. or INSPECT.
+func execInspect(tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i]
+	i++
+
+	if isWord(startTok, "BREAK") {
+		if i >= len(tokens) || !isWord(tokens[i], "POINT") {
+			return i, fmt.Errorf("BREAK: expected POINT after BREAK at %s:%d:%d",
+				startTok.File, startTok.Line, startTok.Column)
+		}
+		i++
+	}
+	i = consumeTerminator(tokens, i)
+
+	if !sicDebug {
+		return i, nil
+	}
+
+	fmt.Fprintf(sicStderr, "[SIC INSPECT] %s:%d:%d\n",
+		startTok.File, startTok.Line, startTok.Column)
+	dumpSigils(sicStderr, sigils)
+
+	if debugInputIsTerminal() {
+		fmt.Fprint(sicStderr, "[SIC INSPECT] press Enter to continue...")
+		bufio.NewReader(sicDebugIn).ReadString('\n')
+	}
+	return i, nil
+}
+
+// dumpSigils writes one line per user-visible sigil, sorted by name.
+// Runtime-internal entries (meta keys, OMEN flags, seals) are skipped and
+// invisible sigils are listed with their value redacted.
+func dumpSigils(w io.Writer, sigils sigilTable) {
+	names := make([]string, 0, len(sigils))
+	for k := range sigils {
+		if strings.HasPrefix(k, "__") {
+			continue
+		}
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		fmt.Fprintln(w, "  (no sigils)")
+		return
+	}
+	for _, name := range names {
+		if isInvisibleSigil(sigils, name) {
+			fmt.Fprintf(w, "  %s = %s (INVISIBLE)\n", name, sicRedacted)
+			continue
+		}
+		val := sigils[name]
+		fmt.Fprintf(w, "  %s = %q (%s)\n", name, val, sigilTypeName(coerceSigilValue(val)))
+	}
+}
+
+func sigilTypeName(v exprValue) string {
+	switch v.kind {
+	case exprInt, exprFloat:
+		return "NUMBER"
+	case exprBool:
+		return "BOOL"
+	default:
+		return "TEXT"
+	}
+}
+
+// debugInputIsTerminal reports whether breakpoints should wait for Enter.
+func debugInputIsTerminal() bool {
+	f, ok := sicDebugIn.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	if fi.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+	// /dev/null is a character device too, but nobody is there to press Enter.
+	if null, err := os.Stat(os.DevNull); err == nil && os.SameFile(fi, null) {
+		return false
+	}
+	return true
+}
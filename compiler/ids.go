@@ -0,0 +1,138 @@
+package compiler
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	mrand "math/rand/v2"
+	"sync"
+)
+
+// ---- ID builtins: UUID(), ULID(), NANOID() ----
+//
+//	LET SIGIL request_id BE UUID().
+//	SEED 42.   // from here on, IDs repeat from run to run
+//
+// All three draw from sicRandom, which is crypto/rand unless a SEED
+// statement swaps in a deterministic stream (or an embedder injects one,
+// as with SetClock for the clock).
+
+var (
+	sicRandomMu sync.Mutex
+	sicRandom   io.Reader = rand.Reader
+)
+
+// readRandom fills b from sicRandom.
+func readRandom(b []byte) error {
+	sicRandomMu.Lock()
+	defer sicRandomMu.Unlock()
+	_, err := io.ReadFull(sicRandom, b)
+	return err
+}
+
+// seedRandom makes sicRandom a deterministic stream derived from seed.
+func seedRandom(seed int64) {
+	var key [32]byte
+	binary.LittleEndian.PutUint64(key[:8], uint64(seed))
+
+	sicRandomMu.Lock()
+	sicRandom = mrand.NewChaCha8(key)
+	sicRandomMu.Unlock()
+}
+
+// execSeed executes: SEED <expr>.
+func execSeed(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "SEED"
+	i++
+
+	exprStart := i
+	for i < len(tokens) && tokens[i].Type != TOK_DOT && tokens[i].Type != TOK_NEWLINE {
+		i++
+	}
+	if exprStart == i {
+		return i, fmt.Errorf("SEED: expected seed value at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+
+	exprTokens := normalizeExprTokens(tokens[exprStart:i])
+	idx := 0
+	v, err := parseOr(prog, exprTokens, &idx, sigils)
+	if err != nil {
+		return i, err
+	}
+	f, ok := v.asFloat()
+	if !ok || f != float64(int64(f)) {
+		return i, fmt.Errorf("SEED: seed must be an integer at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+
+	seedRandom(int64(f))
+	return consumeTerminator(tokens, i), nil
+}
+
+// builtinUUID returns a random (version 4) UUID:
+// xxxxxxxx-xxxx-4xxx-yxxx-xxxxxxxxxxxx.
+func builtinUUID(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 0); err != nil {
+		return exprValue{}, err
+	}
+	var b [16]byte
+	if err := readRandom(b[:]); err != nil {
+		return exprValue{}, err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	h := hex.EncodeToString(b[:])
+	return makeText(h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]), nil
+}
+
+// crockfordBase32 is the ULID alphabet (no I, L, O, U).
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// builtinULID returns a 26-character ULID: 48 bits of millisecond time
+// (from the runtime clock) then 80 random bits, so IDs sort by creation time.
+func builtinULID(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 0); err != nil {
+		return exprValue{}, err
+	}
+	var b [16]byte
+	ms := uint64(sicNow().UnixMilli())
+	for n := 0; n < 6; n++ {
+		b[n] = byte(ms >> (8 * (5 - n)))
+	}
+	if err := readRandom(b[6:]); err != nil {
+		return exprValue{}, err
+	}
+
+	// 128 bits -> 26 base32 digits, most significant first.
+	hi := binary.BigEndian.Uint64(b[:8])
+	lo := binary.BigEndian.Uint64(b[8:])
+	out := make([]byte, 26)
+	for n := 25; n >= 0; n-- {
+		out[n] = crockfordBase32[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return makeText(string(out)), nil
+}
+
+// nanoidAlphabet is URL-safe; its 64 symbols map one-to-one onto 6 bits.
+const nanoidAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// builtinNanoID returns a 21-character URL-safe random ID.
+func builtinNanoID(args []exprValue) (exprValue, error) {
+	if err := wantArgs(args, 0); err != nil {
+		return exprValue{}, err
+	}
+	b := make([]byte, 21)
+	if err := readRandom(b); err != nil {
+		return exprValue{}, err
+	}
+	for n := range b {
+		b[n] = nanoidAlphabet[b[n]&63]
+	}
+	return makeText(string(b)), nil
+}
@@ -2,6 +2,7 @@ package compiler
 
 import (
 	"fmt"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -11,16 +12,28 @@ import (
 
    - Supports:
      * Keywords (LANGUAGE, SCROLL, WORK, MODE, PROFILE, USING, ALTAR, ROUTE, GET, POST, PUT, DELETE, WITH, HANDLER, SIGIL, AS, TEXT, EPHEMERAL, CHAMBER, ENDCHAMBER, THUS, WE, ANSWER, ENDWORK, ENDALTAR, IF, ELSE, END, RAISE, OMEN, SUMMON, SERVICE, LOG, PORT, WEAVE, ENDWEAVE, ARCWORK)
-     * Identifiers
-     * String literals: "like this"
-     * Numbers (simple integers)
-     * Punctuation: . : , / ( ) { } = + - * > < !
-     * Comments: // to end of line
+     * Identifiers, and `escaped` identifiers that are always IDENT even
+       when they spell a keyword (`AND`, `GET`)
+     * String literals: "like this", and raw triple-quoted ones that
+       may span lines: three double quotes on each side, with no
+       escape processing inside
+     * Numbers: integers and decimals (3, 3.14)
+     * Punctuation: . : , / ( ) { } = + - * > < ! ~=
+     * Comments: // to end of line (skipped, or emitted as TOK_COMMENT
+       when SetEmitComments(true) is on, e.g. for sic fmt)
+     * Comments: # to end of line, likewise (SetHashComments(false)
+       turns them off, and # lexes as TOK_ILLEGAL again)
+     * Block comments from /* to the next star-slash, across lines; they
+       do not nest, and an unterminated one lexes as TOK_ILLEGAL "/*"
+       at its opening
      * Newline tracking
 
    - API:
      * NewLexer(source, filename) *Lexer
      * (*Lexer).NextToken() Token
+     * (*Lexer).SetEmitComments(bool)
+     * (*Lexer).SetHashComments(bool)
+     * (*Lexer).Errors() []string
 */
 
 func (t Token) String() string {
@@ -38,20 +51,80 @@ type Lexer struct {
 	ch    rune // current rune
 	width int  // width in bytes of ch
 	done  bool
+
+	emitComments bool // emit TOK_COMMENT instead of skipping comments
+	hashComments bool // treat # as a line comment, like //
+
+	errors []string // one "file:line:col: message" per TOK_ILLEGAL
+
+	tokStart int // byte offset where the token being lexed starts
 }
 
 func NewLexer(src, filename string) *Lexer {
-	l := &Lexer{
-		src:      src,
-		filename: filename,
-		line:     1,
-		column:   0,
-	}
-	l.readRune()
+	l := &Lexer{hashComments: true}
+	l.Reset(src, filename)
 	return l
 }
 
+// Reset reinitializes l to lex src from the beginning, so a Lexer can be
+// reused (e.g. pooled via sync.Pool) instead of allocating a new one per
+// scroll. Options such as SetEmitComments are kept.
+func (l *Lexer) Reset(src, filename string) {
+	l.src = src
+	l.filename = filename
+	l.pos = 0
+	l.line = 1
+	l.column = 0
+	l.ch = 0
+	l.width = 0
+	l.done = false
+	l.errors = nil
+	l.readRune()
+}
+
+// Errors returns a "file:line:col: message" entry for every TOK_ILLEGAL
+// produced so far, in source order. Lexing continues past an illegal
+// token, so one pass reports every problem.
+func (l *Lexer) Errors() []string {
+	return l.errors
+}
+
+// illegal records a lexical error at line:col and returns the matching
+// TOK_ILLEGAL token.
+func (l *Lexer) illegal(lexeme string, line, col int, msg string, args ...interface{}) Token {
+	l.errors = append(l.errors, fmt.Sprintf("%s:%d:%d: %s",
+		l.filename, line, col, fmt.Sprintf(msg, args...)))
+	return l.makeToken(TOK_ILLEGAL, lexeme, line, col)
+}
+
+// SetEmitComments controls whether comments are returned as TOK_COMMENT
+// tokens (lexeme is the full comment text, including "//"). Off by default,
+// so normal parsing never sees comments.
+func (l *Lexer) SetEmitComments(on bool) {
+	l.emitComments = on
+}
+
+// SetHashComments controls whether # starts a comment to end of line.
+// On by default; dialects that give # a meaning can turn it off.
+func (l *Lexer) SetHashComments(on bool) {
+	l.hashComments = on
+}
+
+// readRune advances to the next rune. line and column always describe
+// l.ch itself (1-based), so a "\n" sits at the end of its own line and
+// the rune after it starts the next line at column 1. At EOF they point
+// just past the last rune.
 func (l *Lexer) readRune() {
+	if l.done {
+		return
+	}
+	if l.ch == '\n' {
+		l.line++
+		l.column = 1
+	} else {
+		l.column++
+	}
+
 	if l.pos >= len(l.src) {
 		l.ch = 0
 		l.width = 0
@@ -63,12 +136,6 @@ func (l *Lexer) readRune() {
 	l.ch = r
 	l.width = w
 	l.pos += w
-	if r == '\n' {
-		l.line++
-		l.column = 0
-	} else {
-		l.column++
-	}
 }
 
 func (l *Lexer) peekRune() rune {
@@ -79,6 +146,8 @@ func (l *Lexer) peekRune() rune {
 	return r
 }
 
+// makeToken builds a token that started at l.tokStart and ends just
+// before the current rune (l.pos - l.width, which is len(src) at EOF).
 func (l *Lexer) makeToken(tt TokenType, lexeme string, line, col int) Token {
 	return Token{
 		Type:   tt,
@@ -86,6 +155,8 @@ func (l *Lexer) makeToken(tt TokenType, lexeme string, line, col int) Token {
 		Line:   line,
 		Column: col,
 		File:   l.filename,
+		Offset: l.tokStart,
+		End:    l.pos - l.width,
 	}
 }
 
@@ -93,6 +164,7 @@ func (l *Lexer) makeToken(tt TokenType, lexeme string, line, col int) Token {
 func (l *Lexer) NextToken() Token {
 	// Skip whitespace but keep NEWLINE as its own token
 	for {
+		l.tokStart = l.pos - l.width
 		if l.done {
 			return l.makeToken(TOK_EOF, "", l.line, l.column)
 		}
@@ -102,12 +174,26 @@ func (l *Lexer) NextToken() Token {
 			continue
 		}
 
-		// Comments: // to end of line
-		if l.ch == '/' && l.peekRune() == '/' {
+		// Comments: // (or #) to end of line
+		if (l.ch == '/' && l.peekRune() == '/') || (l.ch == '#' && l.hashComments) {
+			if l.emitComments {
+				return l.lexLineComment()
+			}
 			l.skipLineComment()
 			continue
 		}
 
+		// Block comments: /* ... */, possibly spanning lines
+		if l.ch == '/' && l.peekRune() == '*' {
+			if l.emitComments {
+				return l.lexBlockComment()
+			}
+			if bad, ok := l.skipBlockComment(); !ok {
+				return bad
+			}
+			continue
+		}
+
 		break
 	}
 
@@ -125,11 +211,19 @@ func (l *Lexer) NextToken() Token {
 		return l.makeToken(TOK_EOF, "", line, col)
 	}
 
-	// Strings
+	// Strings: """triple-quoted""" (checked first) or "like this"
+	if l.ch == '"' && strings.HasPrefix(l.src[l.pos:], `""`) {
+		return l.lexTripleString()
+	}
 	if l.ch == '"' {
 		return l.lexString()
 	}
 
+	// Escaped identifiers: `AND` names a sigil, not the keyword
+	if l.ch == '`' {
+		return l.lexEscapedIdent()
+	}
+
 	// Identifiers / keywords
 	if isLetter(l.ch) {
 		return l.lexIdentOrKeyword()
@@ -170,6 +264,10 @@ func (l *Lexer) NextToken() Token {
 	case '=':
 		if l.ch == '=' { // look ahead
 			l.readRune()
+			if l.ch == '=' {
+				l.readRune()
+				return l.makeToken(TOK_STRICT_EQ, "===", line, col)
+			}
 			return l.makeToken(TOK_EQ, "==", line, col)
 		}
 		return l.makeToken(TOK_EQUAL, "=", line, col)
@@ -177,9 +275,21 @@ func (l *Lexer) NextToken() Token {
 	case '!':
 		if l.ch == '=' {
 			l.readRune()
+			if l.ch == '=' {
+				l.readRune()
+				return l.makeToken(TOK_STRICT_NEQ, "!==", line, col)
+			}
 			return l.makeToken(TOK_NEQ, "!=", line, col)
 		}
-		return l.makeToken(TOK_BANG, "!", line, col)
+		// A lone '!' is logical negation, same as NOT.
+		return l.makeToken(TOK_NOT, "!", line, col)
+
+	case '~':
+		if l.ch == '=' {
+			l.readRune()
+			return l.makeToken(TOK_FOLD_EQ, "~=", line, col)
+		}
+		return l.illegal("~", line, col, "unexpected '~' (did you mean '~='?)")
 
 	case '<':
 		if l.ch == '=' {
@@ -205,17 +315,63 @@ func (l *Lexer) NextToken() Token {
 		return l.makeToken(TOK_PERCENT, "%", line, col)
 
 	default:
-		return l.makeToken(TOK_ILLEGAL, string(ch), line, col)
+		return l.illegal(string(ch), line, col, "illegal character %q", ch)
 	}
 }
 
 func (l *Lexer) skipLineComment() {
-	// We are at first '/', peek confirmed second '/'
+	// We are at the "//" or "#" that opens the comment.
 	for !l.done && l.ch != '\n' {
 		l.readRune()
 	}
 }
 
+func (l *Lexer) lexLineComment() Token {
+	line, col := l.line, l.column
+	start := l.pos - l.width
+	l.skipLineComment()
+
+	end := l.pos - l.width
+	if l.done {
+		end = len(l.src)
+	}
+	return l.makeToken(TOK_COMMENT, l.src[start:end], line, col)
+}
+
+// skipBlockComment consumes a /* ... */ comment, starting at its "/".
+// The first "*/" closes it, so block comments do not nest. If the input
+// ends first it reports false and a TOK_ILLEGAL "/*" token positioned at
+// the opening.
+func (l *Lexer) skipBlockComment() (Token, bool) {
+	line, col := l.line, l.column
+	l.readRune() // '/'
+	l.readRune() // '*'
+
+	for !l.done {
+		if l.ch == '*' && l.peekRune() == '/' {
+			l.readRune()
+			l.readRune()
+			return Token{}, true
+		}
+		l.readRune()
+	}
+	return l.illegal("/*", line, col, "unterminated block comment"), false
+}
+
+func (l *Lexer) lexBlockComment() Token {
+	line, col := l.line, l.column
+	start := l.pos - l.width
+	if bad, ok := l.skipBlockComment(); !ok {
+		return bad
+	}
+
+	end := l.pos - l.width
+	if l.done {
+		end = len(l.src)
+	}
+	return l.makeToken(TOK_COMMENT, l.src[start:end], line, col)
+}
+
 func (l *Lexer) lexString() Token {
 	// We are at the opening quote "
 	line, col := l.line, l.column
@@ -259,7 +415,34 @@ func (l *Lexer) lexString() Token {
 	}
 
 	// Unterminated string
-	return l.makeToken(TOK_ILLEGAL, l.src[startPos:l.pos], line, col)
+	end := l.pos - l.width // leave the newline for its own token
+	if l.done {
+		end = len(l.src)
+	}
+	return l.illegal(l.src[startPos:end], line, col, "unterminated string")
+}
+
+// lexTripleString lexes """...""", which may span lines and is raw:
+// backslash escapes are kept as written. An unterminated one lexes as
+// TOK_ILLEGAL `"""` at its opening, like an unterminated block comment.
+func (l *Lexer) lexTripleString() Token {
+	line, col := l.line, l.column
+	l.readRune() // '"'
+	l.readRune() // '"'
+	l.readRune() // '"'
+
+	start := l.pos - l.width
+	for !l.done {
+		if l.ch == '"' && strings.HasPrefix(l.src[l.pos:], `""`) {
+			body := l.src[start : l.pos-l.width]
+			l.readRune()
+			l.readRune()
+			l.readRune()
+			return l.makeToken(TOK_STRING, body, line, col)
+		}
+		l.readRune()
+	}
+	return l.illegal(`"""`, line, col, "unterminated triple-quoted string")
 }
 
 func (l *Lexer) lexNumber() Token {
@@ -270,6 +453,16 @@ func (l *Lexer) lexNumber() Token {
 		l.readRune()
 	}
 
+	// A "." followed by a digit is a decimal point (3.14); any other "."
+	// is left for the statement terminator, so "SAY: 3.14." and "SAY: 3."
+	// both end at their last dot.
+	if !l.done && l.ch == '.' && unicode.IsDigit(l.peekRune()) {
+		l.readRune()
+		for !l.done && unicode.IsDigit(l.ch) {
+			l.readRune()
+		}
+	}
+
 	lex := l.src[start : l.pos-l.width]
 	return l.makeToken(TOK_NUM, lex, line, col)
 }
@@ -292,6 +485,33 @@ func (l *Lexer) lexIdentOrKeyword() Token {
 	return l.makeToken(TOK_IDENT, lex, line, col)
 }
 
+// lexEscapedIdent reads `name` as a TOK_IDENT without consulting the
+// keyword table, so reserved words can still be used as sigil names.
+// The name must be a plain identifier; anything else lexes as ILLEGAL.
+func (l *Lexer) lexEscapedIdent() Token {
+	line, col := l.line, l.column
+	startPos := l.pos - l.width
+	l.readRune() // consume opening backtick
+
+	start := l.pos - l.width
+	for !l.done && (isLetter(l.ch) || unicode.IsDigit(l.ch)) {
+		l.readRune()
+	}
+	name := l.src[start : l.pos-l.width]
+
+	if l.done || l.ch != '`' || name == "" || unicode.IsDigit(rune(name[0])) {
+		for !l.done && l.ch != '`' && l.ch != '\n' {
+			l.readRune()
+		}
+		if !l.done && l.ch == '`' {
+			l.readRune()
+		}
+		return l.illegal(l.src[startPos:l.pos-l.width], line, col, "invalid escaped identifier")
+	}
+	l.readRune() // consume closing backtick
+	return l.makeToken(TOK_IDENT, name, line, col)
+}
+
 func isLetter(r rune) bool {
 	return unicode.IsLetter(r) || r == '_' // allow _ in identifiers
 }
@@ -0,0 +1,249 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ---- LINT: optional style and hygiene checks ----
+//
+// Lint runs on a parsed Program and reports positioned Diagnostics (all
+// warnings). Each rule can be switched on on its own; `sic lint` enables
+// them with flags. Rules work line by line over WORK and hook bodies, so
+// they are deliberately conservative heuristics, not a second parser.
+
+// LintRule names one lint check.
+type LintRule string
+
+const (
+	// LintDots: every statement line ends with "." (block headers with ":").
+	LintDots LintRule = "dots"
+	// LintBareSigils: expressions name sigils as SIGIL x or $x, not bare x.
+	LintBareSigils LintRule = "bare-sigils"
+	// LintNesting: blocks nest no deeper than LintOptions.MaxDepth.
+	LintNesting LintRule = "nesting"
+	// LintUnreachable: nothing follows a top-level SEND BACK / THUS WE ANSWER.
+	LintUnreachable LintRule = "unreachable"
+	// LintMagicNumbers: numbers other than 0 and 1 are bound to a named
+	// sigil (LET SIGIL limit BE 10.) instead of appearing inline.
+	LintMagicNumbers LintRule = "magic-numbers"
+)
+
+// AllLintRules lists every rule in reporting order.
+var AllLintRules = []LintRule{LintDots, LintBareSigils, LintNesting, LintUnreachable, LintMagicNumbers}
+
+// sicDefaultLintDepth is the nesting limit when LintOptions.MaxDepth is 0.
+const sicDefaultLintDepth = 3
+
+// LintOptions selects the rules to run.
+type LintOptions struct {
+	Rules    map[LintRule]bool
+	MaxDepth int
+}
+
+// Lint checks every WORK and hook body of prog against the enabled rules.
+func Lint(prog *Program, opts LintOptions) []Diagnostic {
+	if prog == nil {
+		return nil
+	}
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = sicDefaultLintDepth
+	}
+
+	var out []Diagnostic
+	for _, w := range prog.Works {
+		out = append(out, lintBody(w.Body, opts)...)
+	}
+	for _, h := range prog.Hooks {
+		out = append(out, lintBody(h.Body, opts)...)
+	}
+	return out
+}
+
+// lintLines groups body tokens by source line, dropping NEWLINEs and
+// comments.
+func lintLines(body []Token) [][]Token {
+	var lines [][]Token
+	var cur []Token
+	for _, t := range body {
+		if t.Type == TOK_NEWLINE || t.Type == TOK_COMMENT {
+			continue
+		}
+		if len(cur) > 0 && t.Line != cur[0].Line {
+			lines = append(lines, cur)
+			cur = nil
+		}
+		cur = append(cur, t)
+	}
+	if len(cur) > 0 {
+		lines = append(lines, cur)
+	}
+	return lines
+}
+
+func lintWarn(rule LintRule, t Token, format string, args ...any) Diagnostic {
+	return Diagnostic{
+		Severity: SeverityWarning,
+		Message:  fmt.Sprintf("[%s] ", rule) + fmt.Sprintf(format, args...),
+		File:     t.File,
+		Line:     t.Line,
+		Column:   t.Column,
+	}
+}
+
+// isBlockCloser reports whether a line starting with t closes a block
+// (END, ENDIF, ENDWHILE, ENDOMEN, ENDMATCH, ...).
+func isBlockCloser(t Token) bool {
+	switch t.Type {
+	case TOK_END, TOK_ENDWHILE, TOK_ENDOMEN, TOK_ENDCHOIR, TOK_ENDCHAMBER,
+		TOK_ENDALTAR, TOK_ENDWEAVE, TOK_ENDWORK:
+		return true
+	case TOK_IDENT:
+		return strings.HasPrefix(strings.ToUpper(t.Lexeme), "END")
+	}
+	return false
+}
+
+// isBlockOpener reports whether line opens a nested block: it ends in
+// ":" (or THEN / DO) and is not an arm of an enclosing block.
+func isBlockOpener(line []Token) bool {
+	last := line[len(line)-1]
+	if last.Type != TOK_COLON && !isWord(last, "THEN") && !isWord(last, "DO") {
+		return false
+	}
+	first := line[0]
+	switch {
+	case first.Type == TOK_ELSE, isWord(first, "FALLS_TO_RUIN"),
+		isWord(first, "WHEN"), isWord(first, "OTHERWISE"):
+		return false
+	}
+	return true
+}
+
+func lintBody(body []Token, opts LintOptions) []Diagnostic {
+	var out []Diagnostic
+	depth := 0
+	var returned *Token // top-level SEND BACK / THUS seen at depth 0
+
+	for _, line := range lintLines(body) {
+		first, last := line[0], line[len(line)-1]
+		closer := isBlockCloser(first)
+		opener := !closer && isBlockOpener(line)
+
+		if opts.Rules[LintDots] && last.Type != TOK_DOT && last.Type != TOK_COLON &&
+			!isWord(last, "THEN") && !isWord(last, "DO") {
+			out = append(out, lintWarn(LintDots, last, "statement does not end with '.'"))
+		}
+
+		if opts.Rules[LintUnreachable] && returned != nil && depth == 0 && !closer {
+			out = append(out, lintWarn(LintUnreachable, first,
+				"unreachable: follows the answer at line %d", returned.Line))
+			returned = nil // one report per answer is enough
+		}
+		if depth == 0 && (first.Type == TOK_THUS || lexemeIs(first, "SEND")) {
+			t := first
+			returned = &t
+		}
+
+		if opts.Rules[LintBareSigils] {
+			out = append(out, lintBareSigils(line)...)
+		}
+		if opts.Rules[LintMagicNumbers] {
+			out = append(out, lintMagicNumbers(line)...)
+		}
+
+		if closer && depth > 0 {
+			depth--
+		}
+		if opener {
+			depth++
+			if opts.Rules[LintNesting] && depth > opts.MaxDepth {
+				out = append(out, lintWarn(LintNesting, first,
+					"block nested %d deep (max %d)", depth, opts.MaxDepth))
+			}
+		}
+	}
+	return out
+}
+
+// lintExprWords are IDENT lexemes that may appear in an expression without
+// being sigil references.
+var lintExprWords = map[string]bool{
+	"TIME_NOW": true, "EQUALS": true, "THEN": true, "DO": true,
+	"SECONDS": true, "MINUTES": true, "HOURS": true, "DIV": true, "IN": true, "NATURAL": true,
+	"IS": true, "PRESENT": true, "TRUE": true, "FALSE": true,
+}
+
+// lintBareSigils flags IDENTs used as values inside expressions (after BE,
+// SAY:, IF, WHILE, SEND BACK, THUS WE ANSWER WITH, SLEEP) that are not
+// written as SIGIL x or $x.
+func lintBareSigils(line []Token) []Diagnostic {
+	var out []Diagnostic
+	inExpr := false
+	for i := 0; i < len(line); i++ {
+		t := line[i]
+		switch {
+		case t.Type == TOK_SAY && i+1 < len(line) && line[i+1].Type == TOK_COLON:
+			inExpr = true
+			i++ // the colon belongs to SAY:
+			continue
+		case t.Type == TOK_BE, t.Type == TOK_IF, t.Type == TOK_WHILE, t.Type == TOK_SLEEP,
+			lexemeIs(t, "BACK"), t.Type == TOK_WITH && i > 0 && line[i-1].Type == TOK_ANSWER:
+			inExpr = true
+			continue
+		case t.Type == TOK_DOT, t.Type == TOK_COLON, isWord(t, "THEN"), isWord(t, "DO"),
+			t.Type == TOK_SECONDS, t.Type == TOK_OMEN, t.Type == TOK_YIELDS:
+			// IF OMEN "x" IS PRESENT is not an expression.
+			inExpr = false
+			continue
+		}
+		if !inExpr || t.Type != TOK_IDENT || lintExprWords[strings.ToUpper(t.Lexeme)] {
+			continue
+		}
+		if i+1 < len(line) && line[i+1].Type == TOK_LPAREN {
+			continue // builtin call
+		}
+		if i > 0 {
+			prev := line[i-1]
+			if prev.Type == TOK_SIGIL || prev.Type == TOK_DOLLAR || prev.Type == TOK_WORK {
+				continue
+			}
+			// ELAPSED(name) and COUNT(name) take names, not values.
+			if prev.Type == TOK_LPAREN && i > 1 &&
+				(isWord(line[i-2], "ELAPSED") || isWord(line[i-2], "COUNT")) {
+				continue
+			}
+		}
+		out = append(out, lintWarn(LintBareSigils, t,
+			"bare sigil reference %s; write SIGIL %s or $%s", t.Lexeme, t.Lexeme, t.Lexeme))
+	}
+	return out
+}
+
+// lintMagicNumbers flags numeric literals other than 0 and 1, unless the
+// line just names one (LET SIGIL limit BE 10.), configures an ALTAR, or
+// the number is a WITH PRECISION digit count.
+func lintMagicNumbers(line []Token) []Diagnostic {
+	first := line[0]
+	if first.Type == TOK_ALTAR || first.Type == TOK_PORT {
+		return nil
+	}
+
+	var out []Diagnostic
+	for i, t := range line {
+		if t.Type != TOK_NUM || t.Lexeme == "0" || t.Lexeme == "1" {
+			continue
+		}
+		// LET/STATIC/INVISIBLE SIGIL name BE <number>.
+		if i > 0 && line[i-1].Type == TOK_BE &&
+			(i+1 == len(line) || line[i+1].Type == TOK_DOT) {
+			continue
+		}
+		if i > 0 && isWord(line[i-1], "PRECISION") {
+			continue
+		}
+		out = append(out, lintWarn(LintMagicNumbers, t,
+			"magic number %s; bind it to a named sigil", t.Lexeme))
+	}
+	return out
+}
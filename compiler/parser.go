@@ -2,7 +2,11 @@ package compiler
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 // ===== AST TYPES =====
@@ -10,9 +14,21 @@ import (
 type Program struct {
 	Language string
 	Scroll   string
+	Strength string // "STRONG", "WEAK", or "" when not declared
 	Mode     string
 	Profile  string
 	Works    []*WorkDecl
+	Hooks    []*HookDecl
+
+	// Comments holds top-level comments not attached to a WORK
+	// (only populated when the lexer emits comments).
+	Comments []Token
+}
+
+// IsStrong reports whether the scroll was declared SCROLL STRONG.
+// STRONG scrolls treat warnings as errors.
+func (prog *Program) IsStrong() bool {
+	return prog != nil && prog.Strength == "STRONG"
 }
 
 // WorkDecl represents a WORK block.
@@ -33,15 +49,75 @@ type WorkDecl struct {
 	Ephemeral   bool     // true if declared as WORK EPHEMERAL
 	Sealed      bool
 	SealToken   string
+
+	// Comments directly above the WORK header (only populated when the
+	// lexer emits comments). Comments inside the body stay in Body.
+	Comments []Token
+
+	// Doc is the DOC "..." clause from the header or, failing that, the
+	// text of Comments. Empty when the WORK is undocumented.
+	Doc string
+
+	// Requires lists the sigils named by a REQUIRES SIGIL a, SIGIL b
+	// header clause; execWork checks they are set before the body runs.
+	Requires []string
+
+	// cleanBody caches cleanWorkBody(Body); see (*WorkDecl).execTokens.
+	cleanOnce sync.Once
+	cleanBody []Token
+}
+
+// HookDecl represents a top-level event hook:
+//
+//	WHENEVER WORK ENTERED:
+//	    SAY: "entering " + WORK_NAME.
+//	END.
+type HookDecl struct {
+	Event string // currently only HookWorkEntered
+	Start Token
+	Body  []Token
+}
+
+// HookWorkEntered fires at the start of every declared WORK.
+const HookWorkEntered = "WORK ENTERED"
+
+// ===== DIAGNOSTICS =====
+
+// Severity classifies a Diagnostic.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is a positioned parser message for editors and tooling.
+type Diagnostic struct {
+	Severity Severity
+	Message  string
+	File     string
+	Line     int
+	Column   int
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s", d.File, d.Line, d.Column, d.Severity, d.Message)
 }
 
 // ===== PARSER CORE =====
 
 type Parser struct {
-	l         *Lexer
-	curToken  Token
-	peekToken Token
-	errors    []string
+	l           *Lexer
+	curToken    Token
+	peekToken   Token
+	diagnostics []Diagnostic
+
+	// INCLUDE support: included lexers are stacked on top of l and
+	// drained before the including file resumes. includePaths holds the
+	// absolute path of every file currently being read, root first.
+	includes     []*Lexer
+	includePaths []string
+	pending      []Token
 }
 
 func NewParser(l *Lexer) *Parser {
@@ -52,17 +128,133 @@ func NewParser(l *Lexer) *Parser {
 	return p
 }
 
+// Diagnostics returns every diagnostic reported so far, in the order the
+// parser reported them (a WORK's missing ENDWORK comes after problems
+// found inside its body).
+func (p *Parser) Diagnostics() []Diagnostic {
+	return p.diagnostics
+}
+
+// Errors returns the messages of error-severity diagnostics.
+// Kept for callers that predate Diagnostics.
 func (p *Parser) Errors() []string {
-	return p.errors
+	var out []string
+	for _, d := range p.diagnostics {
+		if d.Severity == SeverityError {
+			out = append(out, d.Message)
+		}
+	}
+	return out
 }
 
-func (p *Parser) addError(msg string, args ...interface{}) {
-	p.errors = append(p.errors, fmt.Sprintf(msg, args...))
+func (p *Parser) addDiagnostic(sev Severity, tok Token, msg string, args ...interface{}) {
+	p.diagnostics = append(p.diagnostics, Diagnostic{
+		Severity: sev,
+		Message:  fmt.Sprintf(msg, args...),
+		File:     tok.File,
+		Line:     tok.Line,
+		Column:   tok.Column,
+	})
+}
+
+func (p *Parser) addError(tok Token, msg string, args ...interface{}) {
+	p.addDiagnostic(SeverityError, tok, msg, args...)
+}
+
+func (p *Parser) addWarning(tok Token, msg string, args ...interface{}) {
+	p.addDiagnostic(SeverityWarning, tok, msg, args...)
 }
 
 func (p *Parser) nextToken() {
 	p.curToken = p.peekToken
-	p.peekToken = p.l.NextToken()
+	p.peekToken = p.readToken()
+}
+
+// readToken returns the next token from the innermost active lexer,
+// expanding INCLUDE "file". directives in place and resuming the
+// including file once an included one reaches EOF.
+func (p *Parser) readToken() Token {
+	for {
+		if len(p.pending) > 0 {
+			tok := p.pending[0]
+			p.pending = p.pending[1:]
+			return tok
+		}
+		lx := p.l
+		if n := len(p.includes); n > 0 {
+			lx = p.includes[n-1]
+		}
+		tok := lx.NextToken()
+		if tok.Type == TOK_EOF && len(p.includes) > 0 {
+			p.includes = p.includes[:len(p.includes)-1]
+			p.includePaths = p.includePaths[:len(p.includePaths)-1]
+			continue
+		}
+		if tok.Type == TOK_IDENT && strings.EqualFold(tok.Lexeme, "INCLUDE") && p.startInclude(lx, tok) {
+			continue
+		}
+		if tok.Type == TOK_ILLEGAL && tok.Lexeme == "/*" {
+			p.addError(tok, "unterminated block comment")
+		}
+		if tok.Type == TOK_ILLEGAL && tok.Lexeme == `"""` {
+			p.addError(tok, "unterminated triple-quoted string")
+		}
+		return tok
+	}
+}
+
+// startInclude handles INCLUDE "file". once its keyword has been read
+// from lx. It reports false (leaving the following token pending) when
+// the keyword is not followed by a string, so INCLUDE stays usable as a
+// plain identifier elsewhere.
+func (p *Parser) startInclude(lx *Lexer, kw Token) bool {
+	pathTok := lx.NextToken()
+	if pathTok.Type != TOK_STRING {
+		p.pending = append(p.pending, pathTok)
+		return false
+	}
+	if term := lx.NextToken(); term.Type != TOK_DOT {
+		p.addError(term, "INCLUDE %q must end with '.'", pathTok.Lexeme)
+		p.pending = append(p.pending, term)
+	}
+
+	if len(p.includePaths) == 0 {
+		p.includePaths = append(p.includePaths, absIncludePath(p.l.filename))
+	}
+	target := pathTok.Lexeme
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(filepath.Dir(kw.File), target)
+	}
+	abs := absIncludePath(target)
+	for i, seen := range p.includePaths {
+		if seen == abs {
+			chain := append(append([]string{}, p.includePaths[i:]...), abs)
+			for j := range chain {
+				chain[j] = filepath.Base(chain[j])
+			}
+			p.addError(kw, "INCLUDE cycle: %s", strings.Join(chain, " -> "))
+			return true
+		}
+	}
+
+	src, err := os.ReadFile(target)
+	if err != nil {
+		p.addError(kw, "INCLUDE %q: %v", pathTok.Lexeme, err)
+		return true
+	}
+	child := NewLexer(string(src), target)
+	child.SetEmitComments(lx.emitComments)
+	child.SetHashComments(lx.hashComments)
+	p.includes = append(p.includes, child)
+	p.includePaths = append(p.includePaths, abs)
+	return true
+}
+
+func absIncludePath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
 }
 
 func (p *Parser) skipNewlines() {
@@ -75,8 +267,20 @@ func (p *Parser) skipNewlines() {
 
 func (p *Parser) ParseProgram() *Program {
 	prog := &Program{}
+	lastWarnLine := 0
+	var pendingComments []Token
 
 	for p.curToken.Type != TOK_EOF {
+		// Comments attach to a WORK only if nothing else comes between.
+		if len(pendingComments) > 0 {
+			switch p.curToken.Type {
+			case TOK_COMMENT, TOK_NEWLINE, TOK_WORK:
+			default:
+				prog.Comments = append(prog.Comments, pendingComments...)
+				pendingComments = nil
+			}
+		}
+
 		switch p.curToken.Type {
 		case TOK_NEWLINE:
 			p.nextToken()
@@ -94,33 +298,194 @@ func (p *Parser) ParseProgram() *Program {
 		case TOK_PROFILE:
 			p.parseProfile(prog)
 
+		case TOK_COMMENT:
+			pendingComments = append(pendingComments, p.curToken)
+			if p.peekToken.Type == TOK_NEWLINE {
+				p.nextToken()
+			}
+			// A blank line detaches comments from the next WORK.
+			if p.peekToken.Type == TOK_NEWLINE {
+				prog.Comments = append(prog.Comments, pendingComments...)
+				pendingComments = nil
+			}
+
 		case TOK_WORK:
 			w := p.parseWork()
 			if w != nil {
+				w.Comments = pendingComments
+				if w.Doc == "" {
+					w.Doc = commentText(pendingComments)
+				}
 				prog.Works = append(prog.Works, w)
+			} else {
+				prog.Comments = append(prog.Comments, pendingComments...)
+			}
+			pendingComments = nil
+
+		case TOK_DOT:
+			// Trailing DOT after a header line or ENDWORK.
+
+		case TOK_IDENT:
+			if isWord(p.curToken, "WHENEVER") {
+				if h := p.parseHook(); h != nil {
+					prog.Hooks = append(prog.Hooks, h)
+				}
+				break
+			}
+			if p.curToken.Line != lastWarnLine {
+				p.addWarning(p.curToken, "unexpected %s at top level", p.curToken.Type)
+				lastWarnLine = p.curToken.Line
 			}
 
 		default:
 			// Unknown / not-yet-handled token at top level:
-			// just advance to avoid infinite loop.
+			// warn once per line and advance to avoid infinite loop.
+			if p.curToken.Line != lastWarnLine {
+				p.addWarning(p.curToken, "unexpected %s at top level", p.curToken.Type)
+				lastWarnLine = p.curToken.Line
+			}
 		}
 
 		p.nextToken()
 	}
 
+	prog.Comments = append(prog.Comments, pendingComments...)
+
+	// SCROLL STRONG: warnings are errors, and unused sigils are reported.
+	if prog.IsStrong() {
+		p.checkUnusedSigils(prog)
+		for k := range p.diagnostics {
+			if p.diagnostics[k].Severity == SeverityWarning {
+				p.diagnostics[k].Severity = SeverityError
+				p.diagnostics[k].Message += " (SCROLL STRONG)"
+			}
+		}
+	}
+
 	return prog
 }
 
+// sicRuntimeReadSigils are sigils the runtime itself reads once a scroll
+// binds them, so binding one is a use.
+var sicRuntimeReadSigils = map[string]bool{
+	sicResponseStatusSigil:      true,
+	sicResponseContentTypeSigil: true,
+	sicResponseBodySigil:        true,
+	"SLEEP_MAX_SECONDS":         true,
+}
+
+// checkUnusedSigils warns about every LET SIGIL (or EPHEMERAL SIGIL) name
+// that nothing in the program reads. It is deliberately generous about
+// what counts as a read: any other identifier with the same spelling, in
+// any WORK or hook, a REQUIRES clause, or a string that mentions the name
+// (COUNT("items") and templates look sigils up by text).
+func (p *Parser) checkUnusedSigils(prog *Program) {
+	var bodies [][]Token
+	for _, w := range prog.Works {
+		bodies = append(bodies, w.Body)
+	}
+	for _, h := range prog.Hooks {
+		bodies = append(bodies, h.Body)
+	}
+
+	read := map[string]bool{}
+	var texts []string
+	var binds []Token
+	for _, w := range prog.Works {
+		for _, name := range w.Requires {
+			read[name] = true
+		}
+	}
+	for _, body := range bodies {
+		for k, t := range body {
+			switch t.Type {
+			case TOK_STRING:
+				texts = append(texts, t.Lexeme)
+			case TOK_IDENT:
+				if k >= 2 && body[k-1].Type == TOK_SIGIL &&
+					(body[k-2].Type == TOK_LET || body[k-2].Type == TOK_EPHEMERAL) &&
+					k+1 < len(body) && body[k+1].Type == TOK_BE {
+					binds = append(binds, t)
+					continue
+				}
+				read[t.Lexeme] = true
+			}
+		}
+	}
+
+	reported := map[string]bool{}
+	for _, t := range binds {
+		name := t.Lexeme
+		if read[name] || reported[name] || sicRuntimeReadSigils[name] {
+			continue
+		}
+		mentioned := false
+		for _, text := range texts {
+			if strings.Contains(text, name) {
+				mentioned = true
+				break
+			}
+		}
+		if !mentioned {
+			p.addWarning(t, "SIGIL %s is bound but never read", name)
+			reported[name] = true
+		}
+	}
+}
+
 // ===== HEADER HELPERS =====
 
+// Language versions this build can run: from SIC 0.1 through SIC 1.x.
+const (
+	sicLangMinMajor = 0
+	sicLangMinMinor = 1
+	sicLangMaxMajor = 1
+)
+
+// parseLanguageVersion parses "SIC 1.0" (or just "1.0") into major/minor.
+func parseLanguageVersion(raw string) (major, minor int, ok bool) {
+	v := strings.TrimSpace(raw)
+	if len(v) >= 3 && strings.EqualFold(v[:3], "SIC") {
+		v = strings.TrimSpace(v[3:])
+	}
+	if v == "" {
+		return 0, 0, false
+	}
+
+	parts := strings.SplitN(v, ".", 2)
+	major, err := strconv.Atoi(parts[0])
+	if err != nil || major < 0 {
+		return 0, 0, false
+	}
+	if len(parts) == 2 {
+		minor, err = strconv.Atoi(parts[1])
+		if err != nil || minor < 0 {
+			return 0, 0, false
+		}
+	}
+	return major, minor, true
+}
+
 // LANGUAGE "SIC 1.0".
 func (p *Parser) parseLanguage(prog *Program) {
 	// curToken is TOK_LANGUAGE
 	p.nextToken()
-	if p.curToken.Type == TOK_STRING {
-		prog.Language = p.curToken.Lexeme
-	} else {
-		p.addError("expected STRING after LANGUAGE, got %s", p.curToken.Type)
+	if p.curToken.Type != TOK_STRING {
+		p.addError(p.curToken, "expected STRING after LANGUAGE, got %s", p.curToken.Type)
+		return
+	}
+	prog.Language = p.curToken.Lexeme
+
+	major, minor, ok := parseLanguageVersion(prog.Language)
+	switch {
+	case !ok:
+		p.addError(p.curToken, "unrecognized LANGUAGE version %q (expected e.g. \"SIC 1.0\")", prog.Language)
+	case major > sicLangMaxMajor:
+		p.addError(p.curToken, "scroll requires LANGUAGE %q, but this interpreter supports up to SIC %d.x",
+			prog.Language, sicLangMaxMajor)
+	case major < sicLangMinMajor || (major == sicLangMinMajor && minor < sicLangMinMinor):
+		p.addError(p.curToken, "LANGUAGE %q is older than the oldest supported version SIC %d.%d",
+			prog.Language, sicLangMinMajor, sicLangMinMinor)
 	}
 	// optional trailing DOT is ignored by parser; lexer already emitted it.
 }
@@ -132,13 +497,18 @@ func (p *Parser) parseScroll(prog *Program) {
 	p.nextToken() // move to possible strength or name
 
 	if p.curToken.Type != TOK_IDENT {
-		p.addError("expected IDENT after SCROLL, got %s", p.curToken.Type)
+		p.addError(p.curToken, "expected IDENT after SCROLL, got %s", p.curToken.Type)
 		return
 	}
 
 	// If we see SCROLL STRONG/WEAK foo, treat the *second* IDENT as the name.
 	if p.peekToken.Type == TOK_IDENT {
-		// strength (ignored for now)
+		strength := strings.ToUpper(p.curToken.Lexeme)
+		if strength != "STRONG" && strength != "WEAK" {
+			p.addWarning(p.curToken, "unknown SCROLL strength %s (expected STRONG or WEAK)", p.curToken.Lexeme)
+		} else {
+			prog.Strength = strength
+		}
 		p.nextToken() // now at scroll name
 		prog.Scroll = p.curToken.Lexeme
 	} else {
@@ -153,7 +523,7 @@ func (p *Parser) parseMode(prog *Program) {
 	if p.curToken.Type == TOK_IDENT {
 		prog.Mode = p.curToken.Lexeme
 	} else {
-		p.addError("expected IDENT after MODE, got %s", p.curToken.Type)
+		p.addError(p.curToken, "expected IDENT after MODE, got %s", p.curToken.Type)
 	}
 }
 
@@ -163,7 +533,49 @@ func (p *Parser) parseProfile(prog *Program) {
 	if p.curToken.Type == TOK_STRING || p.curToken.Type == TOK_IDENT {
 		prog.Profile = p.curToken.Lexeme
 	} else {
-		p.addError("expected STRING/IDENT after PROFILE, got %s", p.curToken.Type)
+		p.addError(p.curToken, "expected STRING/IDENT after PROFILE, got %s", p.curToken.Type)
+	}
+}
+
+// ===== HOOK PARSING =====
+
+// WHENEVER WORK ENTERED: ... END.
+//
+// The body runs until the END that is not closing a nested IF.
+func (p *Parser) parseHook() *HookDecl {
+	h := &HookDecl{Start: p.curToken}
+	p.nextToken()
+
+	if p.curToken.Type != TOK_WORK || !isWord(p.peekToken, "ENTERED") {
+		p.addError(p.curToken, "expected WORK ENTERED after WHENEVER, got %s", p.curToken.Type)
+		return nil
+	}
+	h.Event = HookWorkEntered
+	p.nextToken()
+	p.nextToken()
+
+	if p.curToken.Type != TOK_COLON {
+		p.addError(p.curToken, "expected COLON after WHENEVER WORK ENTERED, got %s", p.curToken.Type)
+		return nil
+	}
+	p.nextToken()
+
+	depth := 0
+	for {
+		switch {
+		case p.curToken.Type == TOK_EOF:
+			p.addError(h.Start, "unterminated WHENEVER (missing END)")
+			return nil
+		case p.curToken.Type == TOK_IF:
+			depth++
+		case p.curToken.Type == TOK_END: // ENDIF lexes as END too
+			if depth == 0 {
+				return h
+			}
+			depth--
+		}
+		h.Body = append(h.Body, p.curToken)
+		p.nextToken()
 	}
 }
 
@@ -177,6 +589,9 @@ func (p *Parser) parseProfile(prog *Program) {
 // We scan the header until the COLON. Any "SIGIL <ident>" pair in the header
 // is recorded as a sigil parameter name, *except* that we don't special-case
 // "UNUSED" here (it just becomes a param name, which is harmless for MAIN).
+// A DOC "<text>" clause anywhere in the header becomes WorkDecl.Doc:
+//
+//   WORK GREETING WITH SIGIL name AS TEXT DOC "Greets name politely.":
 
 // Accept anything that can act as a SIGIL parameter name in a WORK header
 func isSigilNameToken(t Token) bool {
@@ -192,6 +607,30 @@ func isSigilNameToken(t Token) bool {
 	}
 }
 
+// commentText joins comment tokens into plain text, one line each,
+// without their "//" or "#" markers.
+func commentText(comments []Token) string {
+	lines := make([]string, 0, len(comments))
+	for _, c := range comments {
+		if strings.HasPrefix(c.Lexeme, "/*") {
+			// Block comment: one doc line per source line, with the
+			// delimiters and any leading " * " gutter removed.
+			body := strings.TrimSuffix(strings.TrimPrefix(c.Lexeme, "/*"), "*/")
+			for _, ln := range strings.Split(strings.TrimSpace(body), "\n") {
+				ln = strings.TrimPrefix(strings.TrimSpace(ln), "*")
+				lines = append(lines, strings.TrimSpace(ln))
+			}
+			continue
+		}
+		text := strings.TrimPrefix(c.Lexeme, "//")
+		if text == c.Lexeme {
+			text = strings.TrimPrefix(c.Lexeme, "#")
+		}
+		lines = append(lines, strings.TrimSpace(text))
+	}
+	return strings.Join(lines, "\n")
+}
+
 func (p *Parser) parseWork() *WorkDecl {
 	w := &WorkDecl{
 		Start: p.curToken,
@@ -220,14 +659,17 @@ func (p *Parser) parseWork() *WorkDecl {
 
 	// Expect the work name.
 	if p.curToken.Type != TOK_IDENT {
-		p.addError("expected IDENT after WORK, got %s", p.curToken.Type)
+		p.addError(p.curToken, "expected IDENT after WORK, got %s", p.curToken.Type)
 		return nil
 	}
 	w.Name = p.curToken.Lexeme
 
 	// Scan header until COLON, capturing:
 	// - SIGIL params
+	// - REQUIRES SIGIL a, SIGIL b (every SIGIL after REQUIRES, up to a
+	//   later WITH or DOC)
 	// - optional SEAL <token>
+	requires := false
 	for {
 		p.nextToken()
 
@@ -236,27 +678,60 @@ func (p *Parser) parseWork() *WorkDecl {
 			goto bodyStart
 
 		case TOK_EOF:
-			p.addError("unexpected EOF in WORK header for %s", w.Name)
+			p.addError(p.curToken, "unexpected EOF in WORK header for %s", w.Name)
 			return nil
 
 		case TOK_ENDWORK:
-			p.addError("unexpected ENDWORK in WORK header for %s", w.Name)
+			p.addError(p.curToken, "unexpected ENDWORK in WORK header for %s", w.Name)
 			return nil
 
+		case TOK_WITH:
+			// WORK GREET REQUIRES SIGIL region WITH SIGIL name: the
+			// SIGILs after WITH are parameters again.
+			requires = false
+
 		case TOK_SIGIL:
 			p.nextToken()
 			if !isSigilNameToken(p.curToken) {
-				p.addError("expected SIGIL name after SIGIL in WORK header for %s, got %s",
+				p.addError(p.curToken, "expected SIGIL name after SIGIL in WORK header for %s, got %s",
+					w.Name, p.curToken.Type)
+				return nil
+			}
+			if requires {
+				w.Requires = append(w.Requires, p.curToken.Lexeme)
+			} else {
+				w.SigilParams = append(w.SigilParams, p.curToken.Lexeme)
+			}
+
+		case TOK_IDENT:
+			// Required sigils: REQUIRES SIGIL api_key, SIGIL region
+			if strings.EqualFold(p.curToken.Lexeme, "REQUIRES") {
+				requires = true
+				if p.peekToken.Type != TOK_SIGIL {
+					p.addError(p.peekToken, "expected SIGIL after REQUIRES in WORK header for %s, got %s",
+						w.Name, p.peekToken.Type)
+					return nil
+				}
+				continue
+			}
+			// Doc string: DOC "what this WORK does"
+			if !strings.EqualFold(p.curToken.Lexeme, "DOC") {
+				continue
+			}
+			requires = false
+			p.nextToken()
+			if p.curToken.Type != TOK_STRING {
+				p.addError(p.curToken, "expected string after DOC in WORK header for %s, got %s",
 					w.Name, p.curToken.Type)
 				return nil
 			}
-			w.SigilParams = append(w.SigilParams, p.curToken.Lexeme)
+			w.Doc = p.curToken.Lexeme
 
 		case TOK_SEAL:
 			// Header seal token: SEAL "vault_key"  or  SEAL someIdent
 			p.nextToken()
 			if !isSigilNameToken(p.curToken) {
-				p.addError("expected SEAL token after SEAL in WORK header for %s, got %s",
+				p.addError(p.curToken, "expected SEAL token after SEAL in WORK header for %s, got %s",
 					w.Name, p.curToken.Type)
 				return nil
 			}
@@ -270,15 +745,26 @@ func (p *Parser) parseWork() *WorkDecl {
 bodyStart:
 	// If declared SEALED, require SEAL token in header
 	if w.Sealed && strings.TrimSpace(w.SealToken) == "" {
-		p.addError("WORK %s declared SEALED but no SEAL token provided in header", w.Name)
+		p.addError(w.Start, "WORK %s declared SEALED but no SEAL token provided in header", w.Name)
 		return nil
 	}
 
 	// Move to first body token.
 	p.nextToken()
 
-	// Collect body tokens until ENDWORK or EOF.
-	for p.curToken.Type != TOK_EOF && p.curToken.Type != TOK_ENDWORK {
+	// Collect body tokens until ENDWORK. Reaching EOF, or a line that
+	// starts another WORK, means ENDWORK is missing; stop there so the next
+	// declaration is not swallowed into this body.
+	for p.curToken.Type != TOK_ENDWORK {
+		if p.curToken.Type == TOK_EOF {
+			p.addError(w.Start, "WORK %s is missing ENDWORK (reached end of file)", w.Name)
+			return w
+		}
+		if p.curToken.Type == TOK_NEWLINE && p.peekToken.Type == TOK_WORK {
+			p.addError(w.Start, "WORK %s is missing ENDWORK (WORK at %d:%d starts before it ends)",
+				w.Name, p.peekToken.Line, p.peekToken.Column)
+			return w
+		}
 		w.Body = append(w.Body, p.curToken)
 		p.nextToken()
 	}
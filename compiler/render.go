@@ -0,0 +1,175 @@
+package compiler
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// ---- RENDER: text/template responses ----
+//
+//	RENDER "hello.html" WITH SIGIL name, SIGIL count.
+//	RENDER "hello.txt" WITH SIGIL name YIELDS greeting.
+//
+// The template file is loaded relative to the scroll's directory and
+// executed with the named sigils as data ({{.name}}, {{.count}}).
+//
+// Without YIELDS the output becomes RESPONSE_BODY (and, if not already
+// set, RESPONSE_CONTENT_TYPE is derived from the template's extension),
+// so an ALTAR handler WORK can answer with it. With YIELDS the output is
+// bound to a sigil instead; it is INVISIBLE if any argument was.
+//
+// Failures raise catchable OMENs:
+//   - "render_denied": PROFILE "SANDBOX", or a path outside the scroll dir
+//   - "render_failed": the template cannot be read, parsed or executed
+
+const (
+	sicOmenRenderDenied = "render_denied"
+	sicOmenRenderFailed = "render_failed"
+
+	// sicProfileSandbox forbids scrolls from touching the filesystem.
+	sicProfileSandbox = "SANDBOX"
+)
+
+func execRender(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "RENDER"
+	i++
+
+	// Template path: expression up to WITH / YIELDS / end of statement.
+	pathStart := i
+	for i < len(tokens) &&
+		tokens[i].Type != TOK_WITH &&
+		tokens[i].Type != TOK_YIELDS &&
+		tokens[i].Type != TOK_DOT &&
+		tokens[i].Type != TOK_NEWLINE {
+		i++
+	}
+	if pathStart == i {
+		return i, fmt.Errorf("RENDER: expected template path at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	tplPath, err := evalStringExpr(prog, tokens[pathStart:i], sigils)
+	if err != nil {
+		return i, err
+	}
+
+	// Optional: WITH [SIGIL] a, [SIGIL] b, ...
+	data := map[string]string{}
+	tainted := false
+	if i < len(tokens) && tokens[i].Type == TOK_WITH {
+		i++
+		for {
+			if i < len(tokens) && tokens[i].Type == TOK_SIGIL {
+				i++
+			}
+			if i >= len(tokens) || tokens[i].Type != TOK_IDENT {
+				return i, fmt.Errorf("RENDER: expected SIGIL name after WITH at %s:%d:%d",
+					startTok.File, startTok.Line, startTok.Column)
+			}
+			name := tokens[i].Lexeme
+			val, ok := sigils[name]
+			if !ok {
+				return i, fmt.Errorf("RENDER: unknown SIGIL %s at %s:%d:%d",
+					name, tokens[i].File, tokens[i].Line, tokens[i].Column)
+			}
+			data[name] = val
+			tainted = tainted || isInvisibleSigil(sigils, name)
+			i++
+
+			if i < len(tokens) && tokens[i].Type == TOK_COMMA {
+				i++
+				continue
+			}
+			break
+		}
+	}
+
+	// Optional: YIELDS <sigil>
+	yieldsName := ""
+	if i < len(tokens) && tokens[i].Type == TOK_YIELDS {
+		name, next, err := parseSigilTarget(tokens, i+1)
+		if err != nil {
+			return next, fmt.Errorf("RENDER YIELDS: %v at %s:%d:%d",
+				err, tokens[i].File, tokens[i].Line, tokens[i].Column)
+		}
+		yieldsName = name
+		i = next
+	}
+	i = consumeTerminator(tokens, i)
+
+	out, err := renderTemplate(prog, startTok, tplPath, data)
+	if err != nil {
+		return i, err
+	}
+
+	if yieldsName != "" {
+		if tainted {
+			setSigilInvisible(sigils, yieldsName, out)
+		} else {
+			setSigil(sigils, yieldsName, out)
+		}
+		return i, nil
+	}
+
+	setSigilInvisible(sigils, sicResponseBodySigil, out)
+	if _, ok := sigils[sicResponseContentTypeSigil]; !ok {
+		if ct := mime.TypeByExtension(filepath.Ext(tplPath)); ct != "" {
+			setSigilInvisible(sigils, sicResponseContentTypeSigil, ct)
+		}
+	}
+	return i, nil
+}
+
+// renderTemplate resolves tplPath against the scroll's directory, checks
+// the PROFILE gate, and executes the template. Errors are logged to
+// stderr and returned as OMENs.
+func renderTemplate(prog *Program, at Token, tplPath string, data map[string]string) (string, error) {
+	fail := func(omen, format string, args ...interface{}) (string, error) {
+		fmt.Fprintf(os.Stderr, "[SIC RENDER] %s at %s:%d:%d\n",
+			fmt.Sprintf(format, args...), at.File, at.Line, at.Column)
+		return "", &omenError{name: omen}
+	}
+
+	if isSandboxed(prog) {
+		return fail(sicOmenRenderDenied, "file access is not allowed under PROFILE %q", prog.Profile)
+	}
+
+	full, ok := resolveScrollPath(at, tplPath)
+	if !ok {
+		return fail(sicOmenRenderDenied, "template path %q must stay inside the scroll directory", tplPath)
+	}
+
+	src, err := os.ReadFile(full)
+	if err != nil {
+		return fail(sicOmenRenderFailed, "cannot read template: %v", err)
+	}
+	tpl, err := template.New(filepath.Base(full)).Option("missingkey=error").Parse(string(src))
+	if err != nil {
+		return fail(sicOmenRenderFailed, "cannot parse template: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return fail(sicOmenRenderFailed, "cannot execute template: %v", err)
+	}
+	return clampSigilValue(buf.String()), nil
+}
+
+// isSandboxed reports whether prog runs under PROFILE "SANDBOX", which
+// forbids file access.
+func isSandboxed(prog *Program) bool {
+	return prog != nil && strings.EqualFold(prog.Profile, sicProfileSandbox)
+}
+
+// resolveScrollPath resolves p against the directory of the scroll that
+// contains at. ok is false if p is absolute or climbs out of that directory.
+func resolveScrollPath(at Token, p string) (string, bool) {
+	clean := filepath.Clean(p)
+	if filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.Join(filepath.Dir(at.File), clean), true
+}
@@ -2,15 +2,23 @@ package compiler
 
 import (
 	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"mime"
+	"net"
 	"net/http"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 )
 
 // ---- ALTAR runtime ----
@@ -20,6 +28,9 @@ type altarServer struct {
 	mux        *http.ServeMux
 	registered map[string]bool
 	started    bool
+	unixLn     net.Listener // set while serving on a Unix socket
+
+	certFile, keyFile string // serve HTTPS when set (WITH CERT ... KEY ...)
 
 	seal string // if non-empty, ALTAR is sealed and requires matching SEAL to modify
 }
@@ -32,6 +43,9 @@ var (
 const (
 	sicMaxRequestBodyBytes = 1 << 20 // 1 MiB cap (adjust as you like)
 	sicMaxQueryParams      = 64      // cap number of Q_ sigils
+	sicMaxQueryValues      = 32      // cap values per repeated query key
+	sicMaxPathSegments     = 32      // cap number of PATH_<n> sigils
+	sicMaxBodyListItems    = 256     // cap number of BODY_LIST_<n> sigils
 	sicMaxSigilKeyLen      = 64      // cap key portion of Q_<KEY>
 	sicMaxSigilValLen      = 8192    // cap value stored in sigil
 )
@@ -90,11 +104,83 @@ func setRequestSigil(sigils sigilTable, name, value string) {
 	markInvisibleSigil(sigils, name)
 }
 
-// Global entanglement state for the *current* CHAMBER.
+// Entanglement state for the current CHAMBER.
+//
+// Each CHAMBER gets its own frame: the cores entangled in it (name ->
+// storage mode) and the sigil table enclosing it, i.e. the scope a SHARED
+// core publishes into on RELEASE. The CHAMBER's sigil table names its
+// frame under sicEntangleFrameMetaKey, so CHAMBERs running in concurrent
+// CHOIR tasks never see each other's frame. Outside any CHAMBER the root
+// frame (no parent) is used.
+type entangleFrame struct {
+	mu     sync.Mutex
+	cores  map[string]string
+	parent sigilTable
+}
+
+const sicEntangleFrameMetaKey = "__SIC_ENTANGLE_FRAME"
+
+var (
+	entangleFramesMu  sync.Mutex
+	entangleFrames    = map[string]*entangleFrame{}
+	entangleFrameSeq  uint64
+	rootEntangleFrame = &entangleFrame{cores: map[string]string{}}
+)
+
+// pushEntangleFrame registers a frame for a CHAMBER enclosed by parent and
+// returns its id; popEntangleFrame drops it when the CHAMBER exits.
+func pushEntangleFrame(parent sigilTable) (string, *entangleFrame) {
+	f := &entangleFrame{cores: map[string]string{}, parent: parent}
+	entangleFramesMu.Lock()
+	defer entangleFramesMu.Unlock()
+	entangleFrameSeq++
+	id := strconv.FormatUint(entangleFrameSeq, 10)
+	entangleFrames[id] = f
+	return id, f
+}
+
+func popEntangleFrame(id string) {
+	entangleFramesMu.Lock()
+	delete(entangleFrames, id)
+	entangleFramesMu.Unlock()
+}
+
+// currentEntangleFrame returns the frame of the CHAMBER sigils belong to.
+func currentEntangleFrame(sigils sigilTable) *entangleFrame {
+	id, ok := sigils[sicEntangleFrameMetaKey]
+	if !ok {
+		return rootEntangleFrame
+	}
+	entangleFramesMu.Lock()
+	defer entangleFramesMu.Unlock()
+	if f, ok := entangleFrames[id]; ok {
+		return f
+	}
+	return rootEntangleFrame
+}
+
+// ENTANGLE storage modes.
+//
+//   - SHARED: RELEASE publishes the core sigil back to the entangling scope.
+//   - COPY:   the core is a snapshot; changes are discarded with the CHAMBER.
 //
-// For v0 we model this as a single map that CHAMBER saves/restores,
-// so nested CHAMBER blocks each see their own entanglement frame.
-var entangledCores = map[string]bool{}
+// STACK is the legacy spelling of COPY and remains the default.
+const (
+	sicEntangleShared = "SHARED"
+	sicEntangleCopy   = "COPY"
+	sicEntangleStack  = "STACK"
+)
+
+// normalizeEntangleMode validates mode and maps legacy aliases.
+func normalizeEntangleMode(mode string) (string, bool) {
+	switch strings.ToUpper(strings.TrimSpace(mode)) {
+	case sicEntangleShared:
+		return sicEntangleShared, true
+	case sicEntangleCopy, sicEntangleStack:
+		return sicEntangleCopy, true
+	}
+	return "", false
+}
 
 // ---- SIGIL ENVIRONMENT ----
 
@@ -159,12 +245,29 @@ func setSigilInvisible(sigils sigilTable, name, v string) {
 	markInvisibleSigil(sigils, name)
 }
 
+// isWorkLocalMeta reports whether k is runtime state that belongs to the
+// WORK that set it: raised OMEN flags, the OMEN being handled by
+// FALLS_TO_RUIN, STATIC/PERSIST markers and the current WORK name. A
+// summoned WORK starts without them, so e.g. IF OMEN "x" IS PRESENT in a
+// callee only sees OMENs the callee raised itself.
+//
+// Context that must flow into callees (being inside an OMEN try or a
+// WHENEVER hook) is deliberately not listed here.
+func isWorkLocalMeta(k string) bool {
+	return strings.HasPrefix(k, omenPrefix) ||
+		strings.HasPrefix(k, sicStaticMetaPrefix) ||
+		strings.HasPrefix(k, sicPersistMetaPrefix) ||
+		k == sicScopeDepthMetaKey ||
+		k == sicRuinOmenMetaKey ||
+		k == sicCurrentWorkMetaKey
+}
+
 // cloneVisibleSigils copies only visible sigils from src->dst.
 // It also skips all internal meta keys.
 func cloneVisibleSigils(dst, src sigilTable) {
 	for k, v := range src {
 		// skip meta keys entirely
-		if strings.HasPrefix(k, sicInvisibleMetaPrefix) {
+		if strings.HasPrefix(k, sicInvisibleMetaPrefix) || isWorkLocalMeta(k) {
 			continue
 		}
 		// skip invisibles by default
@@ -270,6 +373,20 @@ func exprSingleSigilRef(exprTokens []Token) (string, bool) {
 	return "", false
 }
 
+// consumeTerminator consumes an optional statement-terminating DOT and
+// any NEWLINEs after it, so statements packed onto one line
+// ("SAY: "a". SAY: "b".") and statements on separate lines resume at
+// the same place.
+func consumeTerminator(tokens []Token, i int) int {
+	if i < len(tokens) && tokens[i].Type == TOK_DOT {
+		i++
+	}
+	for i < len(tokens) && tokens[i].Type == TOK_NEWLINE {
+		i++
+	}
+	return i
+}
+
 func redactIfInvisible(sigils sigilTable, name, val string) string {
 	if name != "" && isInvisibleSigil(sigils, name) {
 		return sicRedacted
@@ -332,11 +449,151 @@ func clearAllOmens(sigils sigilTable) {
 
 // ---- PUBLIC ENTRYPOINT ----
 
+// ErrReadFailed and ErrParseFailed are wrapped by RunFile and friends
+// when the scroll cannot be loaded or does not parse, so callers can tell
+// those apart from an error raised while running it.
+var (
+	ErrReadFailed  = errors.New("read failed")
+	ErrParseFailed = errors.New("parse failed")
+)
+
 // RunFile: high-level entry to run a SIC Scroll.
+// MAIN's final THUS WE ANSWER / SEND BACK value is printed to stdout.
 func RunFile(path string) error {
+	_, err := runFile(path, false, nil, nil)
+	return err
+}
+
+// RunFileArgs runs a SIC Scroll like RunFile, passing CLI arguments to
+// MAIN. Arguments bind to MAIN's SIGIL params by position (UNUSED slots
+// are skipped), and every argument is also available as ARG_0, ARG_1, ...
+func RunFileArgs(path string, args []string) error {
+	_, err := runFile(path, false, args, nil)
+	return err
+}
+
+// RunFileWith runs a SIC Scroll like RunFileArgs, first seeding MAIN with
+// the initial sigils (e.g. from LoadConfigSigils). CLI arguments bound to
+// MAIN's params take precedence over initial sigils of the same name.
+func RunFileWith(path string, args []string, initial map[string]string) error {
+	_, err := runFile(path, false, args, initial)
+	return err
+}
+
+// RunFileResult runs a SIC Scroll like RunFile, but captures MAIN's
+// THUS WE ANSWER / SEND BACK value and returns it instead of printing it.
+// A MAIN that never answers returns "".
+func RunFileResult(path string) (string, error) {
+	return runFile(path, true, nil, nil)
+}
+
+// StdinPath is the filename that makes RunFile read the scroll from
+// standard input (`sic run -`). Positions then report StdinName.
+const (
+	StdinPath = "-"
+	StdinName = "<stdin>"
+)
+
+// sicStdin is where StdinPath scrolls and the STDIN sigil are read from.
+// Embedders and tests may swap it with SetStdin.
+var sicStdin io.Reader = os.Stdin
+
+// SetStdin replaces standard input for the runtime; nil restores
+// os.Stdin. A reader that is not an *os.File always counts as piped, so
+// its content becomes the STDIN sigil.
+func SetStdin(r io.Reader) {
+	if r == nil {
+		r = os.Stdin
+	}
+	sicStdin = r
+}
+
+// stdinIsPiped reports whether standard input carries data for the STDIN
+// sigil: a pipe or a redirected file, not a terminal (where reading would
+// block on the user) and not /dev/null.
+func stdinIsPiped() bool {
+	f, ok := sicStdin.(*os.File)
+	if !ok {
+		return sicStdin != nil
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&(os.ModeNamedPipe|os.ModeCharDevice) == os.ModeNamedPipe || fi.Mode().IsRegular()
+}
+
+// programReadsStdin reports whether any WORK or hook of prog names STDIN
+// or STDIN_TRUNCATED, as an identifier or inside a string (COUNT("STDIN")
+// looks a sigil up by text). Scrolls that never do leave stdin unread, so
+// it stays free for INSPECT and a server is not held up by an open pipe.
+func programReadsStdin(prog *Program) bool {
+	var bodies [][]Token
+	for _, w := range prog.Works {
+		bodies = append(bodies, w.Body)
+	}
+	for _, h := range prog.Hooks {
+		bodies = append(bodies, h.Body)
+	}
+	for _, body := range bodies {
+		for _, t := range body {
+			switch t.Type {
+			case TOK_IDENT:
+				if t.Lexeme == "STDIN" || t.Lexeme == "STDIN_TRUNCATED" {
+					return true
+				}
+			case TOK_STRING:
+				if strings.Contains(t.Lexeme, "STDIN") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// withStdinSigil returns initial plus STDIN (the piped input, read fully
+// before MAIN runs) and STDIN_TRUNCATED ("true" when the input was longer
+// than a sigil can hold and was cut off). Without piped input, or when
+// prog never reads STDIN, initial is returned unchanged and STDIN stays
+// unset.
+func withStdinSigil(prog *Program, initial map[string]string) (map[string]string, error) {
+	if !programReadsStdin(prog) || !stdinIsPiped() {
+		return initial, nil
+	}
+	data, err := io.ReadAll(io.LimitReader(sicStdin, sicMaxSigilValLen+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading STDIN: %w", err)
+	}
+	truncated := len(data) > sicMaxSigilValLen
+	if truncated {
+		// Drain the rest so the writer is not cut off mid-pipe.
+		io.Copy(io.Discard, sicStdin)
+	}
+
+	out := make(map[string]string, len(initial)+2)
+	for k, v := range initial {
+		out[k] = v
+	}
+	out["STDIN"] = clampSigilValue(string(data))
+	out["STDIN_TRUNCATED"] = strconv.FormatBool(truncated)
+	return out, nil
+}
+
+// readScroll loads a scroll's source and the filename to report for it.
+func readScroll(path string) ([]byte, string, error) {
+	if path == StdinPath {
+		data, err := io.ReadAll(sicStdin)
+		return data, StdinName, err
+	}
 	data, err := os.ReadFile(path)
+	return data, path, err
+}
+
+func runFile(path string, captureAnswer bool, args []string, initial map[string]string) (string, error) {
+	data, path, err := readScroll(path)
 	if err != nil {
-		return fmt.Errorf("read error: %w", err)
+		return "", fmt.Errorf("%w: %w", ErrReadFailed, err)
 	}
 
 	src := string(data)
@@ -344,19 +601,28 @@ func RunFile(path string) error {
 	p := NewParser(lx)
 
 	prog := p.ParseProgram()
+	for _, d := range p.Diagnostics() {
+		fmt.Fprintf(os.Stderr, "parse %s: %s:%d:%d: %s\n",
+			d.Severity, d.File, d.Line, d.Column, d.Message)
+	}
 	if errs := p.Errors(); len(errs) > 0 {
-		for _, e := range errs {
-			fmt.Fprintln(os.Stderr, "parse error:", e)
+		return "", fmt.Errorf("cannot run: %w", ErrParseFailed)
+	}
+
+	// `sic run -` already consumed stdin as the scroll itself.
+	if path != StdinName {
+		if initial, err = withStdinSigil(prog, initial); err != nil {
+			return "", err
 		}
-		return fmt.Errorf("cannot run: parse failed")
 	}
 
-	return interpretProgram(prog)
+	return interpretProgramWith(prog, captureAnswer, args, initial)
 }
 
-func interpretProgram(prog *Program) error {
+// interpretProgramWith runs MAIN with initial as its starting sigils.
+func interpretProgramWith(prog *Program, captureAnswer bool, args []string, initial map[string]string) (string, error) {
 	if prog == nil {
-		return fmt.Errorf("no program")
+		return "", fmt.Errorf("no program")
 	}
 
 	var mainWork *WorkDecl
@@ -367,12 +633,51 @@ func interpretProgram(prog *Program) error {
 		}
 	}
 	if mainWork == nil {
-		return fmt.Errorf("no MAIN Work found")
+		return "", fmt.Errorf("no MAIN Work found")
 	}
 
 	sigils := make(sigilTable)
-	_, err := execWork(prog, mainWork, sigils, false)
-	return err
+	for k, v := range initial {
+		sigils[k] = clampSigilValue(v)
+	}
+	bindMainArgs(mainWork, sigils, args)
+	defer closeAltarUnixSocket()
+	answer, err := execWork(prog, mainWork, sigils, captureAnswer)
+	var halt *haltError
+	if errors.As(err, &halt) {
+		if halt.code == 0 {
+			return "", nil
+		}
+		return "", &ExitError{Code: halt.code}
+	}
+	return answer, err
+}
+
+// ExitError is returned by RunFile and friends when the scroll stopped
+// itself with HALT WITH CODE <n> (n != 0). Code is the exit status the
+// scroll asked for.
+type ExitError struct {
+	Code int
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("scroll halted with code %d", e.Code)
+}
+
+// bindMainArgs exposes CLI args to MAIN: each arg as ARG_<n>, and the
+// declared SIGIL params bound positionally. A param without a matching
+// arg is left as it was (unset, or seeded by initial sigils), so reading
+// it fails like any other unknown SIGIL.
+func bindMainArgs(mainWork *WorkDecl, sigils sigilTable, args []string) {
+	for n, arg := range args {
+		sigils[fmt.Sprintf("ARG_%d", n)] = clampSigilValue(arg)
+	}
+	for n, param := range mainWork.SigilParams {
+		if param == "UNUSED" || n >= len(args) {
+			continue
+		}
+		sigils[param] = clampSigilValue(args[n])
+	}
 }
 
 // findWork returns the WorkDecl with the given name, or nil.
@@ -406,6 +711,29 @@ func cleanWorkBody(raw []Token) []Token {
 	return raw
 }
 
+// sicNoBodyCache makes every execution re-clean its WORK body, as before
+// the cache existed (SetWorkBodyCache(false)), so the two paths can be
+// compared; scripts/check_body_cache.sh diffs and times them.
+var sicNoBodyCache bool
+
+// SetWorkBodyCache turns the per-WORK cleaned-body cache on or off.
+func SetWorkBodyCache(on bool) {
+	sicNoBodyCache = !on
+}
+
+// execTokens returns the WORK's cleaned body, computed once and then
+// shared by every execution (including concurrent CHOIR / ALTAR ones).
+// Body must not change after the first call.
+func (w *WorkDecl) execTokens() []Token {
+	if sicNoBodyCache {
+		return cleanWorkBody(w.Body)
+	}
+	w.cleanOnce.Do(func() {
+		w.cleanBody = cleanWorkBody(w.Body)
+	})
+	return w.cleanBody
+}
+
 // ----- Expression engine types -----
 
 type exprKind int
@@ -624,8 +952,18 @@ sliced:
 }
 
 func evalStringExprTainted(prog *Program, tokens []Token, sigils sigilTable) (string, bool, error) {
+	val, err := evalExprValue(prog, tokens, sigils)
+	if err != nil {
+		return "", false, err
+	}
+	return val.String(), val.tainted, nil
+}
+
+// evalExprValue is evalStringExprTainted without the final conversion to
+// text, for callers that format the value themselves (SAY WITH PRECISION).
+func evalExprValue(prog *Program, tokens []Token, sigils sigilTable) (exprValue, error) {
 	if len(tokens) == 0 {
-		return "", false, nil
+		return makeText(""), nil
 	}
 
 	end := len(tokens)
@@ -639,28 +977,23 @@ func evalStringExprTainted(prog *Program, tokens []Token, sigils sigilTable) (st
 sliced:
 	tokens = tokens[:end]
 	if len(tokens) == 0 {
-		return "", false, nil
+		return makeText(""), nil
 	}
 
 	tokens = normalizeExprTokens(tokens)
 
 	i := 0
-	val, err := parseOr(prog, tokens, &i, sigils)
-	if err != nil {
-		return "", false, err
-	}
-
-	return val.String(), val.tainted, nil
+	return parseOr(prog, tokens, &i, sigils)
 }
 
 // Precedence:
 //
 // OR
 // AND
-// Equality (==, !=)
+// Equality (==, !=, ===, !==, ~=, IN)
 // Comparison (<, >, <=, >=)
 // Term (+, -)
-// Factor (*, /, %)
+// Factor (*, /, %, DIV)
 // Unary (-, NOT)
 // Primary
 
@@ -671,11 +1004,17 @@ func parseOr(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValu
 	}
 	for *i < len(tokens) && tokens[*i].Type == TOK_OR {
 		*i++
+		if left.asBool() {
+			// Short-circuit: the right side is skipped, not run.
+			*i = skipOperand(tokens, *i, false)
+			left = withTaint(makeBool(true), left.tainted)
+			continue
+		}
 		right, err := parseAnd(prog, tokens, i, sigils)
 		if err != nil {
 			return exprValue{}, err
 		}
-		left = combineTaint(makeBool(left.asBool() || right.asBool()), left, right)
+		left = combineTaint(makeBool(right.asBool()), left, right)
 	}
 	return left, nil
 }
@@ -687,21 +1026,88 @@ func parseAnd(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprVal
 	}
 	for *i < len(tokens) && tokens[*i].Type == TOK_AND {
 		*i++
+		if !left.asBool() {
+			*i = skipOperand(tokens, *i, true)
+			left = withTaint(makeBool(false), left.tainted)
+			continue
+		}
 		right, err := parseEquality(prog, tokens, i, sigils)
 		if err != nil {
 			return exprValue{}, err
 		}
-		left = combineTaint(makeBool(left.asBool() && right.asBool()), left, right)
+		left = combineTaint(makeBool(right.asBool()), left, right)
 	}
 	return left, nil
 }
 
+// skipOperand returns the index just past the operand of AND/OR that
+// starts at i, without evaluating it, so a short-circuited SUMMON never
+// runs and a guarded division never fails. The operand ends at the next
+// OR (or AND, when andOperand) outside parentheses, or where the whole
+// expression ends. Commas after a SUMMON ... WITH belong to the SUMMON,
+// as in skipCallArg.
+func skipOperand(tokens []Token, i int, andOperand bool) int {
+	depth := 0
+	sawSummon, summonArgs := false, false
+	for ; i < len(tokens); i++ {
+		t := tokens[i]
+		switch t.Type {
+		case TOK_LPAREN:
+			depth++
+			continue
+		case TOK_RPAREN:
+			if depth == 0 {
+				return i
+			}
+			depth--
+			continue
+		}
+		if depth > 0 {
+			continue
+		}
+		switch t.Type {
+		case TOK_OR:
+			return i
+		case TOK_AND:
+			if andOperand {
+				return i
+			}
+		case TOK_SUMMON:
+			sawSummon = true
+		case TOK_WITH:
+			if sawSummon {
+				summonArgs = true
+			}
+		case TOK_COMMA:
+			if !summonArgs {
+				return i
+			}
+		case TOK_DOT, TOK_NEWLINE, TOK_COLON, TOK_YIELDS:
+			return i
+		case TOK_IDENT:
+			if isWord(t, "THEN") || isWord(t, "DO") {
+				return i
+			}
+		}
+	}
+	return i
+}
+
 func parseEquality(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
 	left, err := parseComparison(prog, tokens, i, sigils)
 	if err != nil {
 		return exprValue{}, err
 	}
-	for *i < len(tokens) && (tokens[*i].Type == TOK_EQ || tokens[*i].Type == TOK_NEQ) {
+	for *i < len(tokens) && (isEqualityOp(tokens[*i].Type) || isWord(tokens[*i], "IN")) {
+		if isWord(tokens[*i], "IN") {
+			*i++
+			left, err = parseInList(prog, tokens, i, sigils, left)
+			if err != nil {
+				return exprValue{}, err
+			}
+			continue
+		}
+
 		op := tokens[*i].Type
 		*i++
 		right, err := parseComparison(prog, tokens, i, sigils)
@@ -710,18 +1116,17 @@ func parseEquality(prog *Program, tokens []Token, i *int, sigils sigilTable) (ex
 		}
 
 		var eq bool
-		if lf, okL := left.asFloat(); okL {
-			if rf, okR := right.asFloat(); okR {
-				eq = lf == rf
-			} else {
-				eq = left.String() == right.String()
-			}
-		} else {
-			eq = left.String() == right.String()
+		switch op {
+		case TOK_STRICT_EQ, TOK_STRICT_NEQ:
+			eq = valuesStrictEqual(left, right)
+		case TOK_FOLD_EQ:
+			eq = valuesEqualFold(left, right)
+		default:
+			eq = valuesEqual(left, right)
 		}
 
 		var out exprValue
-		if op == TOK_EQ {
+		if op == TOK_EQ || op == TOK_STRICT_EQ || op == TOK_FOLD_EQ {
 			out = makeBool(eq)
 		} else {
 			out = makeBool(!eq)
@@ -731,16 +1136,145 @@ func parseEquality(prog *Program, tokens []Token, i *int, sigils sigilTable) (ex
 	return left, nil
 }
 
+// parseInList parses the right side of x IN ... and reports whether x
+// equals (as with ==) any member. The members are either a literal list,
+// IN ("a", "b", "c"), or one value holding a list: a JSON array
+// ("[\"a\", \"b\"]") or comma-separated text ("a, b").
+func parseInList(prog *Program, tokens []Token, i *int, sigils sigilTable, x exprValue) (exprValue, error) {
+	tainted := x.tainted
+	var members []exprValue
+
+	if *i < len(tokens) && tokens[*i].Type == TOK_LPAREN {
+		openTok := tokens[*i]
+		*i++
+		for *i < len(tokens) && tokens[*i].Type != TOK_RPAREN {
+			v, err := parseOr(prog, tokens, i, sigils)
+			if err != nil {
+				return exprValue{}, err
+			}
+			members = append(members, v)
+			if *i < len(tokens) && tokens[*i].Type == TOK_COMMA {
+				*i++
+				continue
+			}
+			break
+		}
+		if *i >= len(tokens) || tokens[*i].Type != TOK_RPAREN {
+			return exprValue{}, fmt.Errorf("expected ',' or ')' in IN list at %s:%d:%d",
+				openTok.File, openTok.Line, openTok.Column)
+		}
+		*i++
+	} else {
+		list, err := parseComparison(prog, tokens, i, sigils)
+		if err != nil {
+			return exprValue{}, err
+		}
+		tainted = tainted || list.tainted
+		for _, m := range splitListValue(list.String()) {
+			members = append(members, makeText(m))
+		}
+	}
+
+	found := false
+	for _, m := range members {
+		tainted = tainted || m.tainted
+		if !found && valuesEqual(x, m) {
+			found = true
+		}
+	}
+	return withTaint(makeBool(found), tainted), nil
+}
+
+// splitListValue reads a list held in one value: a JSON array (members
+// are rendered as text) or else comma-separated text, trimmed.
+func splitListValue(raw string) []string {
+	var arr []any
+	if err := json.Unmarshal([]byte(raw), &arr); err == nil {
+		out := make([]string, 0, len(arr))
+		for _, v := range arr {
+			if s, ok := v.(string); ok {
+				out = append(out, s)
+				continue
+			}
+			b, _ := json.Marshal(v)
+			out = append(out, string(b))
+		}
+		return out
+	}
+
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	parts := strings.Split(raw, ",")
+	for n := range parts {
+		parts[n] = strings.TrimSpace(parts[n])
+	}
+	return parts
+}
+
+// valuesEqual compares two values numerically when both are numeric,
+// otherwise by their text form. Shared by == / != and MATCH.
+func valuesEqual(left, right exprValue) bool {
+	if lf, okL := left.asFloat(); okL {
+		if rf, okR := right.asFloat(); okR {
+			return lf == rf
+		}
+	}
+	return left.String() == right.String()
+}
+
+// valuesEqualFold backs ~=: like ==, but text is compared ignoring case,
+// so "GET" ~= "get" holds. Numbers still compare numerically.
+func valuesEqualFold(left, right exprValue) bool {
+	if lf, okL := left.asFloat(); okL {
+		if rf, okR := right.asFloat(); okR {
+			return lf == rf
+		}
+	}
+	return strings.EqualFold(left.String(), right.String())
+}
+
+func isEqualityOp(t TokenType) bool {
+	return t == TOK_EQ || t == TOK_NEQ || t == TOK_STRICT_EQ || t == TOK_STRICT_NEQ ||
+		t == TOK_FOLD_EQ
+}
+
+// valuesStrictEqual backs === / !==: both sides must be the same kind
+// (number, text or bool) and hold the same value. Ints and floats count
+// as one kind, so 5 === 5.0 holds, but 5 === "5" and "5" === "5.0" do not.
+func valuesStrictEqual(left, right exprValue) bool {
+	ln := left.kind == exprInt || left.kind == exprFloat
+	rn := right.kind == exprInt || right.kind == exprFloat
+	if ln != rn {
+		return false
+	}
+	if ln {
+		lf, _ := left.asFloat()
+		rf, _ := right.asFloat()
+		return lf == rf
+	}
+	if left.kind != right.kind {
+		return false
+	}
+	return left.String() == right.String()
+}
+
 func parseComparison(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
 	left, err := parseTerm(prog, tokens, i, sigils)
 	if err != nil {
 		return exprValue{}, err
 	}
-	for *i < len(tokens) &&
-		(tokens[*i].Type == TOK_LT ||
-			tokens[*i].Type == TOK_LTE ||
-			tokens[*i].Type == TOK_GT ||
-			tokens[*i].Type == TOK_GTE) {
+	for *i < len(tokens) {
+		// NATURAL < compares text with embedded numbers by value:
+		// "file2" NATURAL < "file10" is true, "file2" < "file10" is not.
+		natural := false
+		if isWord(tokens[*i], "NATURAL") && *i+1 < len(tokens) && isComparisonOp(tokens[*i+1].Type) {
+			natural = true
+			*i++
+		}
+		if !isComparisonOp(tokens[*i].Type) {
+			break
+		}
 
 		op := tokens[*i].Type
 		*i++
@@ -765,17 +1299,21 @@ func parseComparison(prog *Program, tokens []Token, i *int, sigils sigilTable) (
 				res = lf >= rf
 			}
 		} else {
-			ls := left.String()
-			rs := right.String()
+			var cmp int
+			if natural {
+				cmp = naturalCompare(left.String(), right.String())
+			} else {
+				cmp = strings.Compare(left.String(), right.String())
+			}
 			switch op {
 			case TOK_LT:
-				res = ls < rs
+				res = cmp < 0
 			case TOK_LTE:
-				res = ls <= rs
+				res = cmp <= 0
 			case TOK_GT:
-				res = ls > rs
+				res = cmp > 0
 			case TOK_GTE:
-				res = ls >= rs
+				res = cmp >= 0
 			}
 		}
 
@@ -784,6 +1322,58 @@ func parseComparison(prog *Program, tokens []Token, i *int, sigils sigilTable) (
 	return left, nil
 }
 
+func isComparisonOp(t TokenType) bool {
+	return t == TOK_LT || t == TOK_LTE || t == TOK_GT || t == TOK_GTE
+}
+
+// naturalCompare orders a and b like strings.Compare, except that runs of
+// digits compare by numeric value ("file2" < "file10"). Equal values with
+// different leading zeros fall back to the plain byte order.
+func naturalCompare(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if !isDigitByte(a[i]) || !isDigitByte(b[j]) {
+			if a[i] != b[j] {
+				if a[i] < b[j] {
+					return -1
+				}
+				return 1
+			}
+			i++
+			j++
+			continue
+		}
+
+		si, sj := i, j
+		for i < len(a) && isDigitByte(a[i]) {
+			i++
+		}
+		for j < len(b) && isDigitByte(b[j]) {
+			j++
+		}
+		na := strings.TrimLeft(a[si:i], "0")
+		nb := strings.TrimLeft(b[sj:j], "0")
+		if len(na) != len(nb) {
+			if len(na) < len(nb) {
+				return -1
+			}
+			return 1
+		}
+		if c := strings.Compare(na, nb); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case i < len(a):
+		return 1
+	case j < len(b):
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigitByte(c byte) bool { return c >= '0' && c <= '9' }
+
 func parseTerm(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
 	left, err := parseFactor(prog, tokens, i, sigils)
 	if err != nil {
@@ -829,9 +1419,11 @@ func parseFactor(prog *Program, tokens []Token, i *int, sigils sigilTable) (expr
 	for *i < len(tokens) &&
 		(tokens[*i].Type == TOK_STAR ||
 			tokens[*i].Type == TOK_SLASH ||
-			tokens[*i].Type == TOK_PERCENT) {
+			tokens[*i].Type == TOK_PERCENT ||
+			isWord(tokens[*i], "DIV")) {
 
-		op := tokens[*i].Type
+		opTok := tokens[*i]
+		op := opTok.Type
 		*i++
 		right, err := parseUnary(prog, tokens, i, sigils)
 		if err != nil {
@@ -860,6 +1452,15 @@ func parseFactor(prog *Program, tokens []Token, i *int, sigils sigilTable) (expr
 				return exprValue{}, fmt.Errorf("modulo by zero")
 			}
 			out = makeInt(li % ri)
+		case TOK_IDENT: // DIV
+			if lf != math.Trunc(lf) || rf != math.Trunc(rf) {
+				return exprValue{}, fmt.Errorf("DIV needs whole numbers, got %s DIV %s at %s:%d:%d",
+					left.String(), right.String(), opTok.File, opTok.Line, opTok.Column)
+			}
+			if rf == 0 {
+				return exprValue{}, fmt.Errorf("division by zero")
+			}
+			out = makeInt(int64(lf) / int64(rf)) // Go truncates toward zero
 		}
 
 		left = combineTaint(out, left, right)
@@ -899,26 +1500,93 @@ func parseUnary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprV
 	return parsePrimary(prog, tokens, i, sigils)
 }
 
-func parsePrimary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
-	if *i >= len(tokens) {
-		return exprValue{}, fmt.Errorf("unexpected end of expression")
+// coerceSigilValue interprets a stored (text) sigil value as the
+// expression kind it looks like: bool, number, or text.
+func coerceSigilValue(val string) exprValue {
+	s := strings.TrimSpace(val)
+	if strings.EqualFold(s, "true") {
+		return makeBool(true)
 	}
-
-	coerce := func(val string) exprValue {
-		s := strings.TrimSpace(val)
-		if strings.EqualFold(s, "true") {
-			return makeBool(true)
-		}
-		if strings.EqualFold(s, "false") {
-			return makeBool(false)
-		}
-		if f, err := strconv.ParseFloat(s, 64); err == nil {
-			return makeFloat(f)
-		}
-		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
-			return makeInt(n)
-		}
-		return makeText(val)
+	if strings.EqualFold(s, "false") {
+		return makeBool(false)
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return makeFloat(f)
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return makeInt(n)
+	}
+	return makeText(val)
+}
+
+// Strict coercion: reading "007" as the number 7 (or "1.0" as 1) silently
+// changes what the value means. Under --strict-coercion such reads warn on
+// stderr and the coerced value is used; in SCROLL STRONG they are errors.
+var (
+	sicStrictCoercion bool
+	coercionWarnMu    sync.Mutex
+	coercionWarned    = map[string]bool{}
+)
+
+// SetStrictCoercion turns lossy text-to-number coercion warnings on or off.
+func SetStrictCoercion(on bool) {
+	sicStrictCoercion = on
+}
+
+// coercedSpelling is how a coerced number is written when compared with
+// the text it came from: plain decimal digits, never %g's exponent form,
+// so "12345678" spells itself.
+func coercedSpelling(v exprValue) string {
+	if v.kind == exprFloat {
+		return strconv.FormatFloat(v.f, 'f', -1, 64)
+	}
+	return v.String()
+}
+
+// isLossyCoercion reports whether v no longer spells the text it came from.
+func isLossyCoercion(raw string, v exprValue) bool {
+	if v.kind != exprInt && v.kind != exprFloat {
+		return false
+	}
+	return coercedSpelling(v) != strings.TrimSpace(raw)
+}
+
+// coerceSigilRead coerces a sigil value read at tok, applying the strict
+// coercion policy. Each source position warns at most once.
+func coerceSigilRead(prog *Program, name, raw string, tok Token) (exprValue, error) {
+	v := coerceSigilValue(raw)
+	strong := prog.IsStrong()
+	if !(strong || sicStrictCoercion) || !isLossyCoercion(raw, v) {
+		return v, nil
+	}
+
+	msg := fmt.Sprintf("SIGIL %s holds %q, which is read as the number %s at %s:%d:%d",
+		name, raw, coercedSpelling(v), tok.File, tok.Line, tok.Column)
+	if strong {
+		return exprValue{}, fmt.Errorf("%s (SCROLL STRONG)", msg)
+	}
+
+	key := fmt.Sprintf("%s:%d:%d", tok.File, tok.Line, tok.Column)
+	coercionWarnMu.Lock()
+	first := !coercionWarned[key]
+	coercionWarned[key] = true
+	coercionWarnMu.Unlock()
+	if first {
+		fmt.Fprintln(sicStderr, "[SIC WARN] "+msg)
+	}
+	return v, nil
+}
+
+// sicTimeUnits are the time-unit constants usable in expressions, in
+// seconds (the unit of TIME_NOW and SLEEP).
+var sicTimeUnits = map[string]int64{
+	"MINUTES": 60,
+	"HOURS":   60 * 60,
+}
+
+func parsePrimary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exprValue, error) {
+	if *i >= len(tokens) {
+		return exprValue{}, fmt.Errorf("unexpected end of expression")
 	}
 
 	tok := tokens[*i]
@@ -941,7 +1609,7 @@ func parsePrimary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exp
 
 	case TOK_TIME_NOW:
 		*i++
-		return makeInt(time.Now().Unix()), nil
+		return makeInt(sicNow().Unix()), nil
 
 	// "SIGIL name" legacy form
 	case TOK_SIGIL:
@@ -963,7 +1631,10 @@ func parsePrimary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exp
 				name, nameTok.File, nameTok.Line, nameTok.Column)
 		}
 
-		v := coerce(val)
+		v, err := coerceSigilRead(prog, name, val, nameTok)
+		if err != nil {
+			return exprValue{}, err
+		}
 		if isInvisibleSigil(sigils, name) {
 			v = withTaint(v, true)
 		}
@@ -972,6 +1643,12 @@ func parsePrimary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exp
 	// $NAME
 	case TOK_DOLLAR:
 		*i++
+		// TIME_NOW lexes as a keyword, so $TIME_NOW never reaches the
+		// IDENT check below.
+		if *i < len(tokens) && tokens[*i].Type == TOK_TIME_NOW {
+			*i++
+			return makeInt(sicNow().Unix()), nil
+		}
 		if *i >= len(tokens) || tokens[*i].Type != TOK_IDENT {
 			return exprValue{}, fmt.Errorf("expected SIGIL name after $ at %s:%d:%d",
 				tok.File, tok.Line, tok.Column)
@@ -981,7 +1658,7 @@ func parsePrimary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exp
 		*i++
 
 		if strings.EqualFold(name, "TIME_NOW") {
-			return makeInt(time.Now().Unix()), nil
+			return makeInt(sicNow().Unix()), nil
 		}
 
 		val, ok := sigils[name]
@@ -993,7 +1670,10 @@ func parsePrimary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exp
 				name, nameTok.File, nameTok.Line, nameTok.Column)
 		}
 
-		v := coerce(val)
+		v, err := coerceSigilRead(prog, name, val, nameTok)
+		if err != nil {
+			return exprValue{}, err
+		}
 		if isInvisibleSigil(sigils, name) {
 			v = withTaint(v, true)
 		}
@@ -1015,11 +1695,39 @@ func parsePrimary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exp
 		}
 		return makeInt(n), nil
 
-	// Bare IDENT => sigil lookup
+	// Bare IDENT => builtin call or sigil lookup
 	case TOK_IDENT:
+		if isWord(tok, "ELAPSED") && *i+1 < len(tokens) && tokens[*i+1].Type == TOK_LPAREN {
+			return parseElapsedCall(tokens, i)
+		}
+		if isWord(tok, "COUNT") && *i+1 < len(tokens) && tokens[*i+1].Type == TOK_LPAREN {
+			return parseCountCall(tokens, i, sigils)
+		}
+		if isWord(tok, "COALESCE") && *i+1 < len(tokens) && tokens[*i+1].Type == TOK_LPAREN {
+			return parseCoalesceCall(prog, tokens, i, sigils)
+		}
+		if isWord(tok, "QUERY") && *i+1 < len(tokens) && tokens[*i+1].Type == TOK_LPAREN {
+			return parseQueryCall(prog, tokens, i, sigils)
+		}
+		if isWord(tok, "CONFIG") && *i+1 < len(tokens) && tokens[*i+1].Type == TOK_LPAREN {
+			return parseConfigCall(prog, tokens, i, sigils)
+		}
+		if isBuiltinCall(tokens, *i) {
+			return parseBuiltinCall(prog, tokens, i, sigils)
+		}
+
 		if strings.EqualFold(tok.Lexeme, "TIME_NOW") {
 			*i++
-			return makeInt(time.Now().Unix()), nil
+			return makeInt(sicNow().Unix()), nil
+		}
+
+		// MINUTES / HOURS: seconds per unit, so $TIME_NOW + 5 * MINUTES
+		// stays an integer Unix time. A sigil of the same name wins.
+		if secs, ok := sicTimeUnits[strings.ToUpper(tok.Lexeme)]; ok {
+			if _, shadowed := sigils[tok.Lexeme]; !shadowed {
+				*i++
+				return makeInt(secs), nil
+			}
 		}
 
 		val, ok := sigils[tok.Lexeme]
@@ -1033,7 +1741,10 @@ func parsePrimary(prog *Program, tokens []Token, i *int, sigils sigilTable) (exp
 
 		*i++
 
-		v := coerce(val)
+		v, err := coerceSigilRead(prog, tok.Lexeme, val, tok)
+		if err != nil {
+			return exprValue{}, err
+		}
 		if isInvisibleSigil(sigils, tok.Lexeme) {
 			v = withTaint(v, true)
 		}
@@ -1088,10 +1799,57 @@ func classifySigilValue(val string) exprValue {
 	return makeText(val)
 }
 
+// sicInHookMetaKey marks a sigil environment as running inside a
+// WHENEVER hook, so WORKs summoned by the hook do not re-trigger it.
+const sicInHookMetaKey = "__SIC_IN_WHENEVER"
+
+// runWorkEnteredHooks runs every WHENEVER WORK ENTERED hook for w.
+// Hooks see a copy of the WORK's visible sigils plus WORK_NAME; their
+// own sigil changes are discarded. Synthetic block WORKs (IF bodies,
+// CHAMBERs, ...) are not entries and do not fire hooks.
+func runWorkEnteredHooks(prog *Program, w *WorkDecl, sigils sigilTable) error {
+	if prog == nil || len(prog.Hooks) == 0 || sigils[sicInHookMetaKey] != "" {
+		return nil
+	}
+	if findWork(prog, w.Name) != w {
+		return nil
+	}
+
+	for _, h := range prog.Hooks {
+		if h.Event != HookWorkEntered {
+			continue
+		}
+		env := make(sigilTable)
+		cloneVisibleSigils(env, sigils)
+		env["WORK_NAME"] = w.Name
+		env[sicInHookMetaKey] = "true"
+		if err := execBlock(prog, h.Body, env); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRequiredSigils fails when a sigil named in the WORK's REQUIRES
+// clause is unset or blank, naming every one that is missing.
+func checkRequiredSigils(w *WorkDecl, sigils sigilTable) error {
+	var missing []string
+	for _, name := range w.Requires {
+		if v, ok := sigils[name]; !ok || strings.TrimSpace(v) == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("WORK %s: required SIGIL %s missing or empty (REQUIRES at %s:%d:%d)",
+		w.Name, strings.Join(missing, ", "), w.Start.File, w.Start.Line, w.Start.Column)
+}
+
 // execWork runs a single WORK. If captureAnswer is true, it returns the
 // first THUS WE ANSWER / SEND BACK value instead of printing it.
 func execWork(prog *Program, w *WorkDecl, sigils sigilTable, captureAnswer bool) (string, error) {
-	tokens := cleanWorkBody(w.Body)
+	tokens := w.execTokens()
 	i := 0
 
 	// Enforce SEALED WORK capability
@@ -1099,6 +1857,20 @@ func execWork(prog *Program, w *WorkDecl, sigils sigilTable, captureAnswer bool)
 		return "", &omenError{name: "sealed_work"}
 	}
 
+	if err := checkRequiredSigils(w, sigils); err != nil {
+		return "", err
+	}
+
+	if err := runWorkEnteredHooks(prog, w, sigils); err != nil {
+		return "", err
+	}
+
+	// Remember which declared WORK is running so STATIC SIGILs inside its
+	// blocks know whose state they belong to.
+	if findWork(prog, w.Name) == w {
+		sigils[sicCurrentWorkMetaKey] = w.Name
+	}
+
 	if w.Ephemeral {
 		fmt.Printf("[SIC] Entering EPHEMERAL WORK %s.\n", w.Name)
 	}
@@ -1251,6 +2023,17 @@ func execWork(prog *Program, w *WorkDecl, sigils sigilTable, captureAnswer bool)
 			i = next
 			continue
 
+		case TOK_WITH:
+			if !isWithTimeoutStart(tokens, i) {
+				break
+			}
+			next, err := execWithTimeout(prog, tokens, i, sigils)
+			if err != nil {
+				return "", err
+			}
+			i = next
+			continue
+
 		case TOK_SLEEP:
 			next, err := execSleep(prog, tokens, i, sigils)
 			if err != nil {
@@ -1259,7 +2042,17 @@ func execWork(prog *Program, w *WorkDecl, sigils sigilTable, captureAnswer bool)
 			i = next
 			continue
 
-		case TOK_IDENT:
+		case TOK_LOG:
+			// SCRIBE: <expr>.  /  LOG: <expr>.
+			next, err := execLog(prog, tokens, i, sigils)
+			if err != nil {
+				return "", err
+			}
+			i = next
+			continue
+
+		case TOK_IDENT, TOK_SEND:
+			// SEND is a keyword token, but older scrolls may still lex it as IDENT.
 			switch tok.Lexeme {
 			case "SEND":
 				// SEND BACK ...
@@ -1274,6 +2067,167 @@ func execWork(prog *Program, w *WorkDecl, sigils sigilTable, captureAnswer bool)
 				_ = next
 				return "", nil
 
+			case "PASS":
+				// PASS. is an explicit no-op for intentionally empty blocks.
+				i = consumeTerminator(tokens, i+1)
+				continue
+
+			case "BREAK", "INSPECT":
+				// BREAK POINT. / INSPECT. (no-op unless debugging)
+				next, err := execInspect(tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "DUMP", "LOAD":
+				if i+1 >= len(tokens) || !isWord(tokens[i+1], "STATE") {
+					break
+				}
+				next, err := execState(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "RENDER":
+				next, err := execRender(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "RERAISE":
+				next, err := execReraise(tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "DENY":
+				// DENY always unwinds to the ALTAR handler.
+				_, err := execDeny(prog, tokens, i, sigils)
+				return "", err
+
+			case "STATIC":
+				next, err := execStaticSigil(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "VALIDATE":
+				next, err := execValidate(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "HALT":
+				// HALT always unwinds to interpretProgramWith.
+				_, err := execHalt(prog, tokens, i, sigils)
+				return "", err
+
+			case "STREAM":
+				next, err := execStream(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "SEED":
+				next, err := execSeed(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "COUNT":
+				if i+1 >= len(tokens) || !isWord(tokens[i+1], "EVENT") {
+					break
+				}
+				next, err := execCountEvent(tokens, i)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "STOPWATCH":
+				next, err := execStopwatch(tokens, i)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "DO":
+				if !isDoBlockStart(tokens, i) {
+					break
+				}
+				next, err := execDoBlock(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "RETRY":
+				next, err := execRetryBlock(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "MATCH":
+				next, err := execMatch(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "MAP":
+				next, err := execMap(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "FILTER":
+				next, err := execFilter(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "REDUCE":
+				next, err := execReduce(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
+			case "TABLE":
+				next, err := execTable(prog, tokens, i, sigils)
+				if err != nil {
+					return "", err
+				}
+				i = next
+				continue
+
 			case "FALLS_TO_RUIN":
 				next, err := execFallsToRuin(prog, tokens, i, sigils)
 				if err != nil {
@@ -1322,7 +2276,7 @@ func execWork(prog *Program, w *WorkDecl, sigils sigilTable, captureAnswer bool)
 			continue
 
 		case TOK_ENTANGLE:
-			next, err := execEntangle(tokens, i)
+			next, err := execEntangle(tokens, i, sigils)
 			if err != nil {
 				return "", err
 			}
@@ -1330,7 +2284,7 @@ func execWork(prog *Program, w *WorkDecl, sigils sigilTable, captureAnswer bool)
 			continue
 
 		case TOK_RELEASE:
-			next, err := execRelease(tokens, i)
+			next, err := execRelease(tokens, i, sigils)
 			if err != nil {
 				return "", err
 			}
@@ -1400,10 +2354,18 @@ func execSleep(prog *Program, tokens []Token, i int, sigils sigilTable) (int, er
 		return i, fmt.Errorf("SLEEP: duration must be numeric at %s:%d:%d",
 			startTok.File, startTok.Line, startTok.Column)
 	}
+	if math.IsNaN(secs) || math.IsInf(secs, 0) {
+		return i, fmt.Errorf("SLEEP: duration must be a finite number at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
 	if secs < 0 {
 		return i, fmt.Errorf("SLEEP: duration must be >= 0 at %s:%d:%d",
 			startTok.File, startTok.Line, startTok.Column)
 	}
+	if limit := sleepMaxSeconds(sigils); secs > limit {
+		return i, fmt.Errorf("SLEEP: duration %gs exceeds the %gs limit (raise SLEEP_MAX_SECONDS) at %s:%d:%d",
+			secs, limit, startTok.File, startTok.Line, startTok.Column)
+	}
 
 	// Optional SECONDS token or IDENT("SECONDS")
 	if i < len(tokens) && (tokens[i].Type == TOK_SECONDS ||
@@ -1412,57 +2374,244 @@ func execSleep(prog *Program, tokens []Token, i int, sigils sigilTable) (int, er
 	}
 
 	// Optional DOT
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
-	time.Sleep(time.Duration(secs * float64(time.Second)))
+	dur := time.Duration(secs * float64(time.Second))
+	if dl, ok := activeDeadline(sigils); ok {
+		if left := dl.Sub(sicNow()); left < dur {
+			if left > 0 {
+				sicSleep(left)
+			}
+			return i, &omenError{name: "timeout"}
+		}
+	}
+	sicSleep(dur)
 	return i, nil
 }
 
-// ---------------- SAY ----------------
-
-// SAY: <expr>.
-func execSay(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
-	i++ // after SAY
+// sicDefaultSleepMax bounds a single SLEEP so a runaway duration cannot
+// hang the process for years.
+const sicDefaultSleepMax = 3600.0
 
-	if i >= len(tokens) || tokens[i].Type != TOK_COLON {
-		return i, fmt.Errorf("SAY: expected COLON after SAY at %s:%d:%d",
-			tokens[i-1].File, tokens[i-1].Line, tokens[i-1].Column)
+// sleepMaxSeconds reads a SIGIL override, else uses the default.
+// Suggested: SIGIL SLEEP_MAX_SECONDS BE 7200.
+func sleepMaxSeconds(sigils sigilTable) float64 {
+	raw, ok := sigils["SLEEP_MAX_SECONDS"]
+	if !ok || strings.TrimSpace(raw) == "" {
+		return sicDefaultSleepMax
 	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	if err != nil || math.IsNaN(f) || f < 0 {
+		return sicDefaultSleepMax
+	}
+	return f
+}
+
+// ---------------- STOPWATCH / ELAPSED ----------------
+//
+// STOPWATCH start.
+// SAY: "took " + ELAPSED(start) + "s".
+//
+// Stopwatches are runtime-scoped (shared by every WORK in the process)
+// and read the runtime clock (see clock.go) so tests can inject time.
+
+var (
+	stopwatchMu sync.Mutex
+	stopwatches = map[string]time.Time{}
+)
+
+// execStopwatch executes: STOPWATCH <name>.
+func execStopwatch(tokens []Token, i int) (int, error) {
+	startTok := tokens[i] // IDENT "STOPWATCH"
 	i++
 
-	exprStart := i
-	for i < len(tokens) &&
-		tokens[i].Type != TOK_DOT &&
-		tokens[i].Type != TOK_NEWLINE &&
-		tokens[i].Type != TOK_ENDWORK {
-		i++
+	if i >= len(tokens) || (tokens[i].Type != TOK_IDENT && tokens[i].Type != TOK_STRING) {
+		return i, fmt.Errorf("STOPWATCH: expected stopwatch name at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
 	}
-	exprEnd := i
+	name := tokens[i].Lexeme
+	i++
 
-	msg, tainted, err := evalStringExprTainted(prog, tokens[exprStart:exprEnd], sigils)
-	if err != nil {
-		return i, err
-	}
+	stopwatchMu.Lock()
+	stopwatches[name] = sicNow()
+	stopwatchMu.Unlock()
 
-	fmt.Println("[SIC SAY]", redactIfTainted(msg, tainted))
+	return consumeTerminator(tokens, i), nil
+}
 
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
+// stopwatchElapsed returns seconds since STOPWATCH <name> was started.
+func stopwatchElapsed(name string) (float64, error) {
+	stopwatchMu.Lock()
+	started, ok := stopwatches[name]
+	stopwatchMu.Unlock()
+	if !ok {
+		return 0, fmt.Errorf("stopwatch %s was never started", name)
 	}
-	return i, nil
+	d := sicNow().Sub(started)
+	if d < 0 {
+		d = 0
+	}
+	return d.Seconds(), nil
 }
 
-// ---------------- SCRIBE / LOG ----------------
+// ---------------- EVENT COUNTERS ----------------
 //
-// SCRIBE: <expr>.
+// COUNT EVENT "cache_miss".
+// SAY: COUNTERS("cache_miss").   // 1
+// SAY: COUNTERS().               // {"cache_miss":1}
+//
+// Like stopwatches, counters are runtime-scoped: every WORK, CHOIR task
+// and ALTAR handler in the process bumps the same registry.
+
+var (
+	eventCounterMu sync.Mutex
+	eventCounters  = map[string]int64{}
+)
+
+// execCountEvent executes: COUNT EVENT <name>.
+func execCountEvent(tokens []Token, i int) (int, error) {
+	startTok := tokens[i] // IDENT "COUNT"
+	i += 2                // COUNT EVENT
+
+	if i >= len(tokens) || (tokens[i].Type != TOK_IDENT && tokens[i].Type != TOK_STRING) || tokens[i].Lexeme == "" {
+		return i, fmt.Errorf("COUNT EVENT: expected event name at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	name := tokens[i].Lexeme
+	i++
+
+	eventCounterMu.Lock()
+	eventCounters[name]++
+	eventCounterMu.Unlock()
+
+	return consumeTerminator(tokens, i), nil
+}
+
+// eventCount returns how often COUNT EVENT <name> has run (0 if never).
+func eventCount(name string) int64 {
+	eventCounterMu.Lock()
+	defer eventCounterMu.Unlock()
+	return eventCounters[name]
+}
+
+// eventCountersJSON renders every counter as a JSON object with sorted
+// keys, so the same counts always print the same way.
+func eventCountersJSON() string {
+	eventCounterMu.Lock()
+	snapshot := make(map[string]int64, len(eventCounters))
+	for k, v := range eventCounters {
+		snapshot[k] = v
+	}
+	eventCounterMu.Unlock()
+
+	b, _ := json.Marshal(snapshot) // encoding/json sorts map keys
+	return string(b)
+}
+
+// ---------------- SAY ----------------
+
+// Output streams used by SAY / SCRIBE. Embedders and tests may swap them.
+var (
+	sicStdout io.Writer = os.Stdout
+	sicStderr io.Writer = os.Stderr
+)
+
+// SAY: <expr>.
+// SAY ERR: <expr>.   (writes to stderr instead of stdout)
+// SAY WITH PRECISION 2: <expr>.   (see parsePrecisionClause)
+func execSay(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	i++ // after SAY
+
+	out := sicStdout
+	if i < len(tokens) && isWord(tokens[i], "ERR") {
+		out = sicStderr
+		i++
+	}
+
+	prec, i, err := parsePrecisionClause(tokens, i, "SAY")
+	if err != nil {
+		return i, err
+	}
+
+	if i >= len(tokens) || tokens[i].Type != TOK_COLON {
+		return i, fmt.Errorf("SAY: expected COLON after SAY at %s:%d:%d",
+			tokens[i-1].File, tokens[i-1].Line, tokens[i-1].Column)
+	}
+	i++
+
+	exprStart := i
+	for i < len(tokens) &&
+		tokens[i].Type != TOK_DOT &&
+		tokens[i].Type != TOK_NEWLINE &&
+		tokens[i].Type != TOK_ENDWORK {
+		i++
+	}
+	exprEnd := i
+
+	val, err := evalExprValue(prog, tokens[exprStart:exprEnd], sigils)
+	if err != nil {
+		return i, err
+	}
+
+	fmt.Fprintln(out, "[SIC SAY]", redactIfTainted(formatWithPrecision(val, prec), val.tainted))
+
+	i = consumeTerminator(tokens, i)
+	return i, nil
+}
+
+// parsePrecisionClause parses the optional WITH PRECISION <n> that may
+// follow SAY, SAY ERR or SCRIBE. It returns -1 when the clause is absent,
+// leaving the default %g formatting in place.
+func parsePrecisionClause(tokens []Token, i int, verb string) (int, int, error) {
+	if i >= len(tokens) || tokens[i].Type != TOK_WITH ||
+		i+1 >= len(tokens) || !isWord(tokens[i+1], "PRECISION") {
+		return -1, i, nil
+	}
+	withTok := tokens[i]
+	i += 2 // WITH PRECISION
+
+	if i >= len(tokens) || tokens[i].Type != TOK_NUM {
+		return -1, i, fmt.Errorf("%s: expected digit count after WITH PRECISION at %s:%d:%d",
+			verb, withTok.File, withTok.Line, withTok.Column)
+	}
+	n, err := strconv.Atoi(tokens[i].Lexeme)
+	if err != nil || n > sicMaxFormatDecimals {
+		return -1, i, fmt.Errorf("%s: PRECISION must be a whole number from 0 to %d, got %s at %s:%d:%d",
+			verb, sicMaxFormatDecimals, tokens[i].Lexeme, tokens[i].File, tokens[i].Line, tokens[i].Column)
+	}
+	return n, i + 1, nil
+}
+
+// formatWithPrecision renders a SAY / SCRIBE value. With a precision, a
+// numeric result is printed with exactly that many decimals, rounded as
+// FORMAT_NUMBER does; text (including a concatenation that already turned
+// numbers into text) and bools print unchanged.
+func formatWithPrecision(v exprValue, prec int) string {
+	if prec < 0 || (v.kind != exprInt && v.kind != exprFloat) {
+		return v.String()
+	}
+	out, err := builtinFormatNumber([]exprValue{v, makeInt(int64(prec))})
+	if err != nil {
+		return v.String() // NaN / Inf have no fixed-point form
+	}
+	return out.String()
+}
+
+// ---------------- SCRIBE / LOG ----------------
+//
+// SCRIBE: <expr>.
 // LOG: <expr>.
+// SCRIBE WITH PRECISION 2: <expr>.
 // (SCRIBE is the ritual name; LOG is a legacy alias.)
 func execLog(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
 	startTok := tokens[i] // TOK_LOG, lexeme "LOG" or "SCRIBE"
 	i++                   // after LOG / SCRIBE
 
+	prec, i, err := parsePrecisionClause(tokens, i, startTok.Lexeme)
+	if err != nil {
+		return i, err
+	}
+
 	// Expect COLON
 	if i >= len(tokens) || tokens[i].Type != TOK_COLON {
 		return i, fmt.Errorf("%s: expected COLON after %s at %s:%d:%d",
@@ -1480,17 +2629,15 @@ func execLog(prog *Program, tokens []Token, i int, sigils sigilTable) (int, erro
 		i++
 	}
 
-	msg, err := evalStringExpr(prog, tokens[exprStart:i], sigils)
+	val, err := evalExprValue(prog, tokens[exprStart:i], sigils)
 	if err != nil {
 		return i, err
 	}
 
 	// Ritual logging prefix; you can change this styling later.
-	fmt.Println("[SIC SCRIBE]", msg)
+	fmt.Fprintln(sicStdout, "[SIC SCRIBE]", formatWithPrecision(val, prec))
 
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
 	return i, nil
 }
@@ -1541,6 +2688,70 @@ func parseSigilTarget(tokens []Token, i int) (string, int, error) {
 	return name, i + 1, nil
 }
 
+// parseLetTarget parses a LET target: either the static forms accepted
+// by parseSigilTarget, or a computed name:
+//
+//	LET SIGIL ("prefix_" + key) BE value.
+//
+// The parenthesized expression is evaluated at runtime and must yield a
+// legal sigil name.
+func parseLetTarget(prog *Program, tokens []Token, i int, sigils sigilTable) (string, int, error) {
+	j := i
+	if j < len(tokens) && tokens[j].Type == TOK_SIGIL {
+		j++
+	}
+	if j >= len(tokens) || tokens[j].Type != TOK_LPAREN {
+		return parseSigilTarget(tokens, i)
+	}
+
+	// Find the matching ')'.
+	exprStart := j + 1
+	depth := 0
+	for ; j < len(tokens); j++ {
+		switch tokens[j].Type {
+		case TOK_LPAREN:
+			depth++
+		case TOK_RPAREN:
+			depth--
+		case TOK_DOT, TOK_NEWLINE:
+			return "", j, fmt.Errorf("unclosed '(' in computed SIGIL name")
+		}
+		if depth == 0 {
+			break
+		}
+	}
+	if j >= len(tokens) {
+		return "", j, fmt.Errorf("unclosed '(' in computed SIGIL name")
+	}
+
+	name, err := evalStringExpr(prog, tokens[exprStart:j], sigils)
+	if err != nil {
+		return "", j, err
+	}
+	if !isLegalSigilName(name) {
+		return "", j, fmt.Errorf("computed SIGIL name %q is not a legal identifier", name)
+	}
+	return name, j + 1, nil
+}
+
+// isLegalSigilName reports whether name is a plain identifier that user
+// code may assign: [A-Za-z_][A-Za-z0-9_]*, excluding the "__" prefix
+// reserved for runtime metadata.
+func isLegalSigilName(name string) bool {
+	if name == "" || strings.HasPrefix(name, "__") {
+		return false
+	}
+	for n, r := range name {
+		switch {
+		case r == '_', r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+		case r >= '0' && r <= '9' && n > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // execInvisibleSigil executes:
 //
 //	INVISIBLE SIGIL <name> BE <expr>.
@@ -1588,9 +2799,7 @@ func execInvisibleSigil(prog *Program, tokens []Token, i int, sigils sigilTable)
 	setSigilInvisible(sigils, name, val)
 
 	// Optional DOT
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
 	return i, name, nil
 }
@@ -1613,6 +2822,7 @@ func execLet(prog *Program, tokens []Token, i int, sigils sigilTable, ephemeral
 
 	isEphemeral := false
 	isInvisible := false
+	isOuter := false
 
 	// Allow modifiers in any order and tolerate IDENT forms.
 	for i < len(tokens) {
@@ -1636,6 +2846,12 @@ func execLet(prog *Program, tokens []Token, i int, sigils sigilTable, ephemeral
 				i++
 				continue
 			}
+			if strings.EqualFold(tokens[i].Lexeme, "OUTER") ||
+				strings.EqualFold(tokens[i].Lexeme, "PERSIST") {
+				isOuter = true
+				i++
+				continue
+			}
 		}
 		break
 	}
@@ -1644,7 +2860,8 @@ func execLet(prog *Program, tokens []Token, i int, sigils sigilTable, ephemeral
 	//   LET [EPHEMERAL] [INVISIBLE] SIGIL X BE ...
 	//   LET [EPHEMERAL] [INVISIBLE] X BE ...
 	//   LET [EPHEMERAL] [INVISIBLE] $X BE ...
-	name, next, err := parseSigilTarget(tokens, i)
+	//   LET [EPHEMERAL] [INVISIBLE] SIGIL (<expr>) BE ...   (computed name)
+	name, next, err := parseLetTarget(prog, tokens, i, sigils)
 	if err != nil {
 		return i, fmt.Errorf("LET: %v at %s:%d:%d", err, startTok.File, startTok.Line, startTok.Column)
 	}
@@ -1688,12 +2905,122 @@ func execLet(prog *Program, tokens []Token, i int, sigils sigilTable, ephemeral
 		ephemeral[name] = true
 	}
 
+	// LET OUTER / LET PERSIST: survive IF/WHILE block scoping. Outside
+	// any block there is nothing to survive, so no marker is needed.
+	if _, inBlock := sigils[sicScopeDepthMetaKey]; isOuter && inBlock {
+		sigils[sicPersistMetaPrefix+name] = "true"
+	}
+
+	// STATIC SIGILs write through to their WORK's store.
+	if owner, ok := sigils[sicStaticMetaPrefix+name]; ok {
+		storeStaticSigil(owner, name, val)
+	}
+
 	// Optional trailing DOT
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
+	i = consumeTerminator(tokens, i)
+
+	return i, nil
+}
+
+// ---------------- STATIC SIGIL ----------------
+//
+//	STATIC SIGIL hits BE 0.
+//	LET SIGIL hits BE hits + 1.
+//
+// A STATIC SIGIL belongs to the declaring WORK rather than to one call:
+// the initializer runs only the first time, later SUMMONs of the same WORK
+// see the last value assigned, and callers' sigils never touch it. Values
+// live in a runtime map keyed by WORK name, guarded by staticMu so CHOIR
+// and ALTAR can summon the WORK concurrently. (Each LET is stored
+// atomically, but a read-modify-write across concurrent calls is not.)
+
+const (
+	// sicCurrentWorkMetaKey names the declared WORK currently executing.
+	sicCurrentWorkMetaKey = "__SIC_CURRENT_WORK"
+
+	// sicStaticMetaPrefix marks a sigil bound by STATIC SIGIL; the value
+	// is the owning WORK's name.
+	sicStaticMetaPrefix = "__SIC_META_STATIC__"
+)
+
+var (
+	staticMu     sync.Mutex
+	staticSigils = map[string]map[string]string{} // WORK name -> sigil -> value
+)
+
+func storeStaticSigil(work, name, val string) {
+	staticMu.Lock()
+	defer staticMu.Unlock()
+	if staticSigils[work] == nil {
+		staticSigils[work] = map[string]string{}
+	}
+	staticSigils[work][name] = val
+}
+
+// execStaticSigil executes: STATIC SIGIL <name> BE <expr>.
+func execStaticSigil(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "STATIC"
+	i++
+
+	if i < len(tokens) && tokens[i].Type == TOK_SIGIL {
+		i++
+	}
+	if i >= len(tokens) || tokens[i].Type != TOK_IDENT {
+		return i, fmt.Errorf("STATIC: expected SIGIL name at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	name := tokens[i].Lexeme
+	i++
+
+	if i >= len(tokens) || !(tokens[i].Type == TOK_BE ||
+		(tokens[i].Type == TOK_IDENT && strings.EqualFold(tokens[i].Lexeme, "BE"))) {
+		return i, fmt.Errorf("STATIC: expected BE after SIGIL %s at %s:%d:%d",
+			name, tokens[i-1].File, tokens[i-1].Line, tokens[i-1].Column)
+	}
+	i++
+
+	exprStart := i
+	for i < len(tokens) &&
+		tokens[i].Type != TOK_DOT &&
+		tokens[i].Type != TOK_NEWLINE &&
+		tokens[i].Type != TOK_ENDWORK {
 		i++
 	}
 
-	return i, nil
+	owner := sigils[sicCurrentWorkMetaKey]
+	if owner == "" {
+		return i, fmt.Errorf("STATIC: SIGIL %s must be declared inside a WORK at %s:%d:%d",
+			name, startTok.File, startTok.Line, startTok.Column)
+	}
+
+	staticMu.Lock()
+	val, ok := staticSigils[owner][name]
+	staticMu.Unlock()
+
+	if !ok {
+		// First declaration: run the initializer once. Two concurrent
+		// first calls may both evaluate it; the first store wins.
+		init, err := evalStringExpr(prog, tokens[exprStart:i], sigils)
+		if err != nil {
+			return i, err
+		}
+		staticMu.Lock()
+		if staticSigils[owner] == nil {
+			staticSigils[owner] = map[string]string{}
+		}
+		if prev, ok := staticSigils[owner][name]; ok {
+			init = prev
+		} else {
+			staticSigils[owner][name] = init
+		}
+		staticMu.Unlock()
+		val = init
+	}
+
+	setSigil(sigils, name, val)
+	sigils[sicStaticMetaPrefix+name] = owner
+
+	return consumeTerminator(tokens, i), nil
 }
 
 // EPHEMERAL SIGIL name BE <expr>.
@@ -1742,18 +3069,17 @@ func execEphemeralSigil(prog *Program, tokens []Token, i int, sigils sigilTable)
 	}
 	setSigil(sigils, name, val)
 
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
 	return i, name, nil
 }
 
-// ENTANGLE CORE calc_space WITH "STACK".
+// ENTANGLE CORE calc_space WITH "SHARED".
 // ENTANGLE calc_space.
-// For now, ENTANGLE is bookkeeping only: we track which core names
-// are "entangled" inside the current CHAMBER.
-func execEntangle(tokens []Token, i int) (int, error) {
+// We track which core names are "entangled" inside the current CHAMBER,
+// and with which storage mode (see sicEntangleShared / sicEntangleCopy).
+// The core's state is the sigil of the same name.
+func execEntangle(tokens []Token, i int, sigils sigilTable) (int, error) {
 	startTok := tokens[i] // TOK_ENTANGLE
 	i++
 
@@ -1769,30 +3095,44 @@ func execEntangle(tokens []Token, i int) (int, error) {
 	name := tokens[i].Lexeme
 	i++
 
-	// Optional: WITH <mode> (ignored for now).
+	// Optional: WITH <mode>.
+	mode := sicEntangleCopy
 	if i < len(tokens) && tokens[i].Type == TOK_WITH {
+		withTok := tokens[i]
 		i++
-		if i < len(tokens) && (tokens[i].Type == TOK_STRING || tokens[i].Type == TOK_IDENT) {
-			i++ // storage mode, ignored for now
+		if i >= len(tokens) || (tokens[i].Type != TOK_STRING && tokens[i].Type != TOK_IDENT) {
+			return i, fmt.Errorf("ENTANGLE: expected storage mode after WITH at %s:%d:%d",
+				withTok.File, withTok.Line, withTok.Column)
+		}
+		m, ok := normalizeEntangleMode(tokens[i].Lexeme)
+		if !ok {
+			return i, fmt.Errorf("ENTANGLE: unknown storage mode %q (want %q or %q) at %s:%d:%d",
+				tokens[i].Lexeme, sicEntangleShared, sicEntangleCopy,
+				tokens[i].File, tokens[i].Line, tokens[i].Column)
 		}
+		mode = m
+		i++
 	}
 
 	// Optional trailing DOT.
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
 	// Bookkeeping.
-	if entangledCores[name] {
+	f := currentEntangleFrame(sigils)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.cores[name]; ok {
 		return i, fmt.Errorf("ENTANGLE: core %s entangled twice in same CHAMBER at %s:%d:%d",
 			name, startTok.File, startTok.Line, startTok.Column)
 	}
-	entangledCores[name] = true
+	f.cores[name] = mode
 	return i, nil
 }
 
 // RELEASE calc_space.
-func execRelease(tokens []Token, i int) (int, error) {
+// For a SHARED core, the current value of the core sigil (and its
+// visibility) is published to the scope enclosing the CHAMBER.
+func execRelease(tokens []Token, i int, sigils sigilTable) (int, error) {
 	startTok := tokens[i] // TOK_RELEASE
 	i++
 
@@ -1804,15 +3144,31 @@ func execRelease(tokens []Token, i int) (int, error) {
 	i++
 
 	// Optional trailing DOT.
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
-	if !entangledCores[name] {
+	f := currentEntangleFrame(sigils)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	mode, ok := f.cores[name]
+	if !ok {
 		return i, fmt.Errorf("RELEASE: core %s not entangled in this CHAMBER at %s:%d:%d",
 			name, startTok.File, startTok.Line, startTok.Column)
 	}
-	delete(entangledCores, name)
+	delete(f.cores, name)
+
+	if parent := f.parent; mode == sicEntangleShared && parent != nil {
+		if val, ok := sigils[name]; ok {
+			parent[name] = val
+			if isInvisibleSigil(sigils, name) {
+				markInvisibleSigil(parent, name)
+			} else {
+				unmarkInvisibleSigil(parent, name)
+			}
+		} else {
+			delete(parent, name)
+			unmarkInvisibleSigil(parent, name)
+		}
+	}
 	return i, nil
 }
 
@@ -1862,9 +3218,7 @@ func execThus(prog *Program, tokens []Token, i int, sigils sigilTable) (string,
 	}
 
 	// Optional trailing dot
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
 	return val, i, nil
 }
@@ -1900,38 +3254,8 @@ func execSendBack(prog *Program, tokens []Token, i int, sigils sigilTable) (stri
 		i++
 	}
 
-	// Special-case: SEND BACK SIGIL name.
-	if i < len(tokens) && tokens[i].Type == TOK_SIGIL {
-		i++
-
-		if i >= len(tokens) || tokens[i].Type != TOK_IDENT {
-			return "", i, fmt.Errorf(
-				"SEND BACK: expected SIGIL name after SIGIL at %s:%d:%d",
-				tokens[i-1].File, tokens[i-1].Line, tokens[i-1].Column,
-			)
-		}
-		name := tokens[i].Lexeme
-		val, _ := getSigil(sigils, name)
-		i++
-
-		for i < len(tokens) &&
-			tokens[i].Type != TOK_DOT &&
-			tokens[i].Type != TOK_NEWLINE &&
-			tokens[i].Type != TOK_ENDWORK {
-			i++
-		}
-		if i < len(tokens) && tokens[i].Type == TOK_DOT {
-			i++
-		}
-
-		// Redact if invisible
-		if isInvisibleSigil(sigils, name) {
-			return sicRedacted, i, nil
-		}
-		return val, i, nil
-	}
-
-	// General: SEND BACK <expr>.
+	// SEND BACK <expr>. "SIGIL name" is normalized to a plain sigil
+	// reference, so both forms share the same taint/redaction path.
 	exprStart := i
 	for i < len(tokens) &&
 		tokens[i].Type != TOK_DOT &&
@@ -1946,9 +3270,7 @@ func execSendBack(prog *Program, tokens []Token, i int, sigils sigilTable) (stri
 		return "", i, err
 	}
 
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
 	return redactIfTainted(val, tainted), i, nil
 }
@@ -2066,7 +3388,7 @@ func execIf(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error
 		if elseStart != -1 {
 			thenEnd = elseStart
 		}
-		if err := execBlock(prog, tokens[thenStart:thenEnd], sigils); err != nil {
+		if err := execScopedBlock(prog, tokens[thenStart:thenEnd], sigils); err != nil {
 			return endPos + 1, err
 		}
 	} else if elseStart != -1 {
@@ -2080,7 +3402,7 @@ func execIf(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error
 		for k < endPos && tokens[k].Type == TOK_NEWLINE {
 			k++
 		}
-		if err := execBlock(prog, tokens[k:endPos], sigils); err != nil {
+		if err := execScopedBlock(prog, tokens[k:endPos], sigils); err != nil {
 			return endPos + 1, err
 		}
 	}
@@ -2178,7 +3500,7 @@ func execIfOmen(prog *Program, tokens []Token, i int, sigils sigilTable) (int, e
 		if elseStart != -1 {
 			thenEnd = elseStart
 		}
-		if err := execBlock(prog, tokens[thenStart:thenEnd], sigils); err != nil {
+		if err := execScopedBlock(prog, tokens[thenStart:thenEnd], sigils); err != nil {
 			return endPos + 1, err
 		}
 	} else if elseStart != -1 {
@@ -2189,7 +3511,7 @@ func execIfOmen(prog *Program, tokens []Token, i int, sigils sigilTable) (int, e
 		for k < endPos && tokens[k].Type == TOK_NEWLINE {
 			k++
 		}
-		if err := execBlock(prog, tokens[k:endPos], sigils); err != nil {
+		if err := execScopedBlock(prog, tokens[k:endPos], sigils); err != nil {
 			return endPos + 1, err
 		}
 	}
@@ -2242,15 +3564,19 @@ func execEphemeral(prog *Program, tokens []Token, i int, sigils sigilTable) (int
 	setSigil(sigils, name, val)
 
 	// Optional trailing DOT
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
 	return i, name, nil
 }
 
 // ---------------- WHILE ----------------
 //
+// Header forms:
+//
+//	WHILE cond:
+//	WHILE cond DO:
+//	WHILE cond DO        (colon optional when a NEWLINE follows)
+//
 // WHILE SIGIL count EQUALS "0":
 //
 //	SAY: "Loop turn " + count + ".".
@@ -2268,17 +3594,36 @@ func execWhile(prog *Program, tokens []Token, i int, sigils sigilTable) (int, er
 		i++
 	}
 
-	// Condition tokens until COLON
+	// Condition tokens until COLON / DO / THEN / NEWLINE
 	condStart := i
-	for i < len(tokens) && tokens[i].Type != TOK_COLON {
+	for i < len(tokens) &&
+		tokens[i].Type != TOK_COLON &&
+		tokens[i].Type != TOK_NEWLINE &&
+		!isWord(tokens[i], "DO") &&
+		!isWord(tokens[i], "THEN") {
 		i++
 	}
-	if i >= len(tokens) || tokens[i].Type != TOK_COLON {
+	condTokens := tokens[condStart:i]
+	if len(condTokens) == 0 {
+		return i, fmt.Errorf("WHILE: expected condition after WHILE at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+
+	// Optional DO / THEN
+	if i < len(tokens) && (isWord(tokens[i], "DO") || isWord(tokens[i], "THEN")) {
+		i++
+	}
+
+	// COLON, or (leniently) a NEWLINE that starts the body.
+	switch {
+	case i < len(tokens) && tokens[i].Type == TOK_COLON:
+		i++ // after COLON
+	case i < len(tokens) && tokens[i].Type == TOK_NEWLINE:
+		// WHILE cond DO   (no colon)
+	default:
 		return i, fmt.Errorf("WHILE: expected COLON after condition at %s:%d:%d",
 			startTok.File, startTok.Line, startTok.Column)
 	}
-	condTokens := tokens[condStart:i]
-	i++ // after COLON
 
 	// Skip NEWLINEs before body
 	for i < len(tokens) && tokens[i].Type == TOK_NEWLINE {
@@ -2318,6 +3663,9 @@ func execWhile(prog *Program, tokens []Token, i int, sigils sigilTable) (int, er
 			return endPos + 1, fmt.Errorf("WHILE: exceeded %d iterations", maxWhileIterations)
 		}
 		iterations++
+		if err := checkDeadline(sigils); err != nil {
+			return endPos + 1, err
+		}
 
 		ok, err := evalBoolExpr(prog, condTokens, sigils)
 		if err != nil {
@@ -2327,7 +3675,7 @@ func execWhile(prog *Program, tokens []Token, i int, sigils sigilTable) (int, er
 			break
 		}
 
-		if err := execBlock(prog, tokens[bodyStart:endPos], sigils); err != nil {
+		if err := execScopedBlock(prog, tokens[bodyStart:endPos], sigils); err != nil {
 			return endPos + 1, err
 		}
 	}
@@ -2340,23 +3688,494 @@ func execWhile(prog *Program, tokens []Token, i int, sigils sigilTable) (int, er
 	return k, nil
 }
 
-// ---------------- CHAMBER v0.1 ----------------
+// ---------------- MATCH / WHEN ----------------
 //
-// CHAMBER my_scope:
-//     LET SIGIL gold BE "999".
-//     SAY: "Inside: " + gold + ".".
-// ENDCHAMBER.
+// MATCH <expr>:
 //
-// Semantics v0.1:
-// - CHAMBER creates a *scoped* execution environment.
-// - We clone the parent's sigils into a child table.
-// - We execute the body using execWork on a synthetic WorkDecl.
-// - Any changes made inside the CHAMBER (even non-EPHEMERAL) are discarded
-//   when we return; the parent sigils are untouched.
-
-// CHAMBER name:
+//	WHEN "a":
+//	    SAY: "got a".
+//	WHEN "b":
+//	    SAY: "got b".
+//	OTHERWISE:
+//	    SAY: "something else".
 //
-//	...
+// ENDMATCH.
+//
+// The subject is evaluated exactly once. WHEN values are compared in order
+// with the same rules as ==; the first matching arm runs (no fallthrough).
+// OTHERWISE runs when no WHEN matched.
+func execMatch(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "MATCH"
+	i++
+
+	// Subject tokens until COLON
+	subjStart := i
+	for i < len(tokens) && tokens[i].Type != TOK_COLON && tokens[i].Type != TOK_NEWLINE {
+		i++
+	}
+	if i >= len(tokens) || tokens[i].Type != TOK_COLON {
+		return i, fmt.Errorf("MATCH: expected COLON after subject at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	subjTokens := tokens[subjStart:i]
+	if len(subjTokens) == 0 {
+		return i, fmt.Errorf("MATCH: expected subject after MATCH at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	i++ // after COLON
+
+	type matchArm struct {
+		cond      []Token // nil for OTHERWISE
+		otherwise bool
+		bodyStart int
+		bodyEnd   int
+	}
+	var arms []*matchArm
+
+	// Find arms and the matching ENDMATCH, respecting nesting.
+	endPos := -1
+	depth := 1
+	for j := i; j < len(tokens); j++ {
+		t := tokens[j]
+
+		if isWord(t, "MATCH") {
+			depth++
+			continue
+		}
+		if isWord(t, "ENDMATCH") {
+			depth--
+			if depth == 0 {
+				endPos = j
+				break
+			}
+			continue
+		}
+		if depth != 1 || !(isWord(t, "WHEN") || isWord(t, "OTHERWISE")) {
+			if depth == 1 && len(arms) == 0 && t.Type != TOK_NEWLINE {
+				return j, fmt.Errorf("MATCH: expected WHEN or OTHERWISE, got %s at %s:%d:%d",
+					t.Type, t.File, t.Line, t.Column)
+			}
+			continue
+		}
+
+		if len(arms) > 0 {
+			arms[len(arms)-1].bodyEnd = j
+		}
+
+		arm := &matchArm{otherwise: isWord(t, "OTHERWISE")}
+		k := j + 1
+		condStart := k
+		for k < len(tokens) && tokens[k].Type != TOK_COLON && tokens[k].Type != TOK_NEWLINE {
+			k++
+		}
+		if k >= len(tokens) || tokens[k].Type != TOK_COLON {
+			return k, fmt.Errorf("MATCH: expected COLON after %s at %s:%d:%d",
+				strings.ToUpper(t.Lexeme), t.File, t.Line, t.Column)
+		}
+		if !arm.otherwise {
+			arm.cond = tokens[condStart:k]
+			if len(arm.cond) == 0 {
+				return k, fmt.Errorf("MATCH: expected value after WHEN at %s:%d:%d",
+					t.File, t.Line, t.Column)
+			}
+		} else if k != condStart {
+			return k, fmt.Errorf("MATCH: unexpected tokens after OTHERWISE at %s:%d:%d",
+				t.File, t.Line, t.Column)
+		}
+		arm.bodyStart = k + 1
+		arms = append(arms, arm)
+		j = k
+	}
+
+	if endPos == -1 {
+		return i, fmt.Errorf("MATCH: unmatched ENDMATCH for MATCH at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	if len(arms) > 0 {
+		arms[len(arms)-1].bodyEnd = endPos
+	}
+
+	// Evaluate the subject exactly once.
+	idx := 0
+	subject, err := parseOr(prog, normalizeExprTokens(subjTokens), &idx, sigils)
+	if err != nil {
+		return endPos + 1, err
+	}
+
+	var chosen *matchArm
+	var fallback *matchArm
+	for _, arm := range arms {
+		if arm.otherwise {
+			if fallback == nil {
+				fallback = arm
+			}
+			continue
+		}
+		idx := 0
+		want, err := parseOr(prog, normalizeExprTokens(arm.cond), &idx, sigils)
+		if err != nil {
+			return endPos + 1, err
+		}
+		if valuesEqual(subject, want) {
+			chosen = arm
+			break
+		}
+	}
+	if chosen == nil {
+		chosen = fallback
+	}
+
+	if chosen != nil {
+		if err := execBlock(prog, tokens[chosen.bodyStart:chosen.bodyEnd], sigils); err != nil {
+			return endPos + 1, err
+		}
+	}
+
+	return consumeTerminator(tokens, endPos+1), nil
+}
+
+// ---------------- TABLE / ROW ----------------
+//
+// TABLE:
+//
+//	ROW "Name", "Score".
+//	ROW name, score.
+//
+// ENDTABLE.
+//
+// Each ROW evaluates its comma-separated cells in order. At ENDTABLE the
+// rows are written to sicStdout with every column padded to its widest
+// cell; short rows are padded with empty cells. Tainted cells print as
+// the redaction marker, as with SAY.
+func execTable(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "TABLE"
+	i++
+
+	if i >= len(tokens) || tokens[i].Type != TOK_COLON {
+		return i, fmt.Errorf("TABLE: expected COLON after TABLE at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	i++
+
+	var rows [][]string
+	for {
+		for i < len(tokens) && tokens[i].Type == TOK_NEWLINE {
+			i++
+		}
+		if i >= len(tokens) || tokens[i].Type == TOK_ENDWORK {
+			return i, fmt.Errorf("TABLE: unmatched ENDTABLE for TABLE at %s:%d:%d",
+				startTok.File, startTok.Line, startTok.Column)
+		}
+		t := tokens[i]
+		if isWord(t, "ENDTABLE") {
+			i++
+			break
+		}
+		if !isWord(t, "ROW") {
+			return i, fmt.Errorf("TABLE: expected ROW or ENDTABLE, got %s at %s:%d:%d",
+				t.Type, t.File, t.Line, t.Column)
+		}
+		i++
+
+		cellStart := i
+		for i < len(tokens) && tokens[i].Type != TOK_DOT && tokens[i].Type != TOK_NEWLINE {
+			i++
+		}
+		cellTokens := normalizeExprTokens(tokens[cellStart:i])
+		i = consumeTerminator(tokens, i)
+
+		var row []string
+		idx := 0
+		for idx < len(cellTokens) {
+			v, err := parseOr(prog, cellTokens, &idx, sigils)
+			if err != nil {
+				return i, err
+			}
+			row = append(row, redactIfTainted(v.String(), v.tainted))
+			if idx < len(cellTokens) && cellTokens[idx].Type == TOK_COMMA {
+				idx++
+				continue
+			}
+			if idx < len(cellTokens) {
+				bad := cellTokens[idx]
+				return i, fmt.Errorf("TABLE: expected ',' between cells at %s:%d:%d",
+					bad.File, bad.Line, bad.Column)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	writeTable(sicStdout, rows)
+	return consumeTerminator(tokens, i), nil
+}
+
+// writeTable prints rows as aligned columns separated by two spaces.
+// Widths count runes, so non-ASCII text lines up; the last column is not
+// padded, so lines carry no trailing spaces.
+func writeTable(out io.Writer, rows [][]string) {
+	var widths []int
+	for _, row := range rows {
+		for c, cell := range row {
+			if c == len(widths) {
+				widths = append(widths, 0)
+			}
+			if n := utf8.RuneCountInString(cell); n > widths[c] {
+				widths[c] = n
+			}
+		}
+	}
+
+	for _, row := range rows {
+		var b strings.Builder
+		for c, w := range widths {
+			cell := ""
+			if c < len(row) {
+				cell = row[c]
+			}
+			if c == len(widths)-1 {
+				b.WriteString(cell)
+				break
+			}
+			b.WriteString(cell)
+			b.WriteString(strings.Repeat(" ", w-utf8.RuneCountInString(cell)+2))
+		}
+		fmt.Fprintln(out, "[SIC TABLE]", strings.TrimRight(b.String(), " "))
+	}
+}
+
+// ---------------- MAP / FILTER / REDUCE ----------------
+//
+//	MAP prices WITH WORK DOUBLE INTO doubled.
+//	FILTER prices WITH WORK IS_EVEN INTO evens.
+//	REDUCE prices WITH WORK ADD FROM 0 INTO total.
+//
+// Both summon a one-parameter WORK once per element of a list sigil (a
+// JSON array or comma-separated text, as for IN) and bind a new sigil
+// holding a JSON array, in element order. MAP collects the answers
+// (numeric answers stay JSON numbers); FILTER keeps the elements whose
+// answer is truthy by the usual rules ("true", a non-zero number, any
+// other non-blank text). REDUCE instead summons a two-parameter WORK with
+// (accumulator, element), starting from the FROM value, and binds the
+// last answer; an empty list yields the FROM value itself. An error in
+// any element stops the statement and names the index; an OMEN passes
+// through unchanged.
+
+// listWorkStmt is a parsed MAP / FILTER / REDUCE statement.
+type listWorkStmt struct {
+	verb      string
+	at        Token
+	work      *WorkDecl
+	seed      string // REDUCE's FROM value
+	target    string
+	items     []string
+	invisible bool
+}
+
+// parseListWorkStmt parses: <VERB> <list> WITH WORK <name> INTO <sigil>.
+// The WORK must take exactly params SIGILs. With params == 2 (REDUCE) a
+// FROM <expr> clause is required before INTO.
+func parseListWorkStmt(prog *Program, tokens []Token, i int, sigils sigilTable, params int) (listWorkStmt, int, error) {
+	startTok := tokens[i] // IDENT "MAP" / "FILTER" / "REDUCE"
+	st := listWorkStmt{verb: strings.ToUpper(startTok.Lexeme), at: startTok}
+	i++
+
+	listName, next, err := parseSigilTarget(tokens, i)
+	if err != nil {
+		return st, next, fmt.Errorf("%s: %v at %s:%d:%d",
+			st.verb, err, startTok.File, startTok.Line, startTok.Column)
+	}
+	i = next
+
+	if i+2 >= len(tokens) || tokens[i].Type != TOK_WITH || tokens[i+1].Type != TOK_WORK ||
+		tokens[i+2].Type != TOK_IDENT {
+		return st, i, fmt.Errorf("%s: expected WITH WORK <name> after %s at %s:%d:%d",
+			st.verb, listName, startTok.File, startTok.Line, startTok.Column)
+	}
+	workTok := tokens[i+2]
+	i += 3
+
+	if params == 2 {
+		if i >= len(tokens) || tokens[i].Type != TOK_FROM {
+			return st, i, fmt.Errorf("%s: expected FROM <start value> after WORK %s at %s:%d:%d",
+				st.verb, workTok.Lexeme, startTok.File, startTok.Line, startTok.Column)
+		}
+		i++
+		seedStart := i
+		for i < len(tokens) && !isWord(tokens[i], "INTO") &&
+			tokens[i].Type != TOK_DOT && tokens[i].Type != TOK_NEWLINE {
+			i++
+		}
+		if seedStart == i {
+			return st, i, fmt.Errorf("%s: expected start value after FROM at %s:%d:%d",
+				st.verb, startTok.File, startTok.Line, startTok.Column)
+		}
+		st.seed, err = evalStringExpr(prog, tokens[seedStart:i], sigils)
+		if err != nil {
+			return st, i, err
+		}
+	}
+
+	if i >= len(tokens) || !isWord(tokens[i], "INTO") {
+		return st, i, fmt.Errorf("%s: expected INTO <sigil> after WORK %s at %s:%d:%d",
+			st.verb, workTok.Lexeme, startTok.File, startTok.Line, startTok.Column)
+	}
+	st.target, next, err = parseSigilTarget(tokens, i+1)
+	if err != nil {
+		return st, next, fmt.Errorf("%s INTO: %v at %s:%d:%d",
+			st.verb, err, tokens[i].File, tokens[i].Line, tokens[i].Column)
+	}
+	i = consumeTerminator(tokens, next)
+
+	raw, ok := sigils[listName]
+	if !ok {
+		return st, i, fmt.Errorf("%s: unknown SIGIL %s at %s:%d:%d",
+			st.verb, listName, startTok.File, startTok.Line, startTok.Column)
+	}
+	st.work = findWork(prog, workTok.Lexeme)
+	if st.work == nil {
+		return st, i, fmt.Errorf("%s: unknown WORK %s at %s:%d:%d",
+			st.verb, workTok.Lexeme, workTok.File, workTok.Line, workTok.Column)
+	}
+	if len(st.work.SigilParams) != params {
+		return st, i, fmt.Errorf("%s: WORK %s must take exactly %d SIGIL(s), it takes %d at %s:%d:%d",
+			st.verb, st.work.Name, params, len(st.work.SigilParams), workTok.File, workTok.Line, workTok.Column)
+	}
+	st.items = splitListValue(raw)
+	st.invisible = isInvisibleSigil(sigils, listName)
+	return st, i, nil
+}
+
+// summon runs the statement's WORK for element n with args bound to its
+// params, returning the WORK's answer.
+func (st listWorkStmt) summon(prog *Program, sigils sigilTable, n int, args ...string) (string, error) {
+	child := make(sigilTable)
+	cloneVisibleSigils(child, sigils)
+	for k, arg := range args {
+		child[st.work.SigilParams[k]] = arg
+		if st.invisible {
+			markInvisibleSigil(child, st.work.SigilParams[k])
+		}
+	}
+
+	answer, err := execWork(prog, st.work, child, true)
+	if err == nil {
+		return answer, nil
+	}
+	item := st.items[n]
+	if oe, ok := err.(*omenError); ok {
+		fmt.Fprintf(os.Stderr, "[SIC %s] element %d (%q) raised OMEN %s at %s:%d:%d\n",
+			st.verb, n, item, oe.name, st.at.File, st.at.Line, st.at.Column)
+		return "", oe
+	}
+	return "", fmt.Errorf("%s: element %d (%q): %v at %s:%d:%d",
+		st.verb, n, item, err, st.at.File, st.at.Line, st.at.Column)
+}
+
+// bind stores value in the target sigil, INVISIBLE if the list was.
+func (st listWorkStmt) bind(sigils sigilTable, value string) {
+	if st.invisible {
+		setSigilInvisible(sigils, st.target, clampSigilValue(value))
+	} else {
+		setSigil(sigils, st.target, clampSigilValue(value))
+	}
+}
+
+// listJSONValue renders v as a JSON number when it reads as one, else as
+// a JSON string.
+func listJSONValue(v string) any {
+	if _, err := strconv.ParseFloat(v, 64); err == nil && json.Valid([]byte(v)) {
+		return json.RawMessage(v)
+	}
+	return v
+}
+
+// execMap executes: MAP <list> WITH WORK <name> INTO <sigil>.
+func execMap(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	st, i, err := parseListWorkStmt(prog, tokens, i, sigils, 1)
+	if err != nil {
+		return i, err
+	}
+
+	out := make([]any, len(st.items)) // indexed, so order never depends on scheduling
+	for n, item := range st.items {
+		answer, err := st.summon(prog, sigils, n, item)
+		if err != nil {
+			return i, err
+		}
+		out[n] = listJSONValue(answer)
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return i, fmt.Errorf("MAP: cannot encode result: %v at %s:%d:%d",
+			err, st.at.File, st.at.Line, st.at.Column)
+	}
+	st.bind(sigils, string(data))
+	return i, nil
+}
+
+// execFilter executes: FILTER <list> WITH WORK <name> INTO <sigil>.
+func execFilter(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	st, i, err := parseListWorkStmt(prog, tokens, i, sigils, 1)
+	if err != nil {
+		return i, err
+	}
+
+	out := make([]any, 0, len(st.items))
+	for n, item := range st.items {
+		answer, err := st.summon(prog, sigils, n, item)
+		if err != nil {
+			return i, err
+		}
+		if coerceSigilValue(answer).asBool() {
+			out = append(out, listJSONValue(item))
+		}
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		return i, fmt.Errorf("FILTER: cannot encode result: %v at %s:%d:%d",
+			err, st.at.File, st.at.Line, st.at.Column)
+	}
+	st.bind(sigils, string(data))
+	return i, nil
+}
+
+// execReduce executes: REDUCE <list> WITH WORK <name> FROM <expr> INTO <sigil>.
+func execReduce(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	st, i, err := parseListWorkStmt(prog, tokens, i, sigils, 2)
+	if err != nil {
+		return i, err
+	}
+
+	acc := st.seed
+	for n, item := range st.items {
+		acc, err = st.summon(prog, sigils, n, acc, item)
+		if err != nil {
+			return i, err
+		}
+	}
+	st.bind(sigils, acc)
+	return i, nil
+}
+
+// ---------------- CHAMBER v0.1 ----------------
+//
+// CHAMBER my_scope:
+//     LET SIGIL gold BE "999".
+//     SAY: "Inside: " + gold + ".".
+// ENDCHAMBER.
+//
+// Semantics v0.1:
+// - CHAMBER creates a *scoped* execution environment.
+// - We clone the parent's sigils into a child table.
+// - We execute the body using execWork on a synthetic WorkDecl.
+// - Any changes made inside the CHAMBER (even non-EPHEMERAL) are discarded
+//   when we return; the parent sigils are untouched.
+
+// CHAMBER name:
+//
+//	...
 //
 // ENDCHAMBER.
 //
@@ -2410,36 +4229,93 @@ foundEnd:
 	// New sigil scope (does not leak back out of the chamber).
 	childSigils := cloneSigils(sigils)
 
-	// Save/restore entanglement frame for this CHAMBER.
-	oldEntangled := entangledCores
-	entangledCores = map[string]bool{}
+	// Fresh entanglement frame for this CHAMBER.
+	frameID, frame := pushEntangleFrame(sigils)
+	defer popEntangleFrame(frameID)
+	childSigils[sicEntangleFrameMetaKey] = frameID
 
 	// Execute the chamber body.
 	if err := execBlock(prog, tokens[bodyStart:endPos], childSigils); err != nil {
-		entangledCores = oldEntangled
 		return endPos + 1, err
 	}
 
 	// Check for entangle leaks.
-	if len(entangledCores) != 0 {
-		entangledCores = oldEntangled
+	frame.mu.Lock()
+	leaked := len(frame.cores) != 0
+	frame.mu.Unlock()
+	if leaked {
 		return endPos + 1, fmt.Errorf(
 			"EPHEMERAL: entangle leak in CHAMBER at %s:%d:%d",
 			startTok.File, startTok.Line, startTok.Column,
 		)
 	}
 
-	// Restore outer entanglement frame.
-	entangledCores = oldEntangled
-
 	// Move index to just after ENDCHAMBER (and optional trailing DOT).
 	i = endPos + 1
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 	return i, nil
 }
 
+const (
+	// sicPersistMetaPrefix marks a sigil created by LET OUTER / LET
+	// PERSIST, which outlives the IF/WHILE block that created it (and any
+	// blocks enclosing that one).
+	sicPersistMetaPrefix = "__SIC_META_PERSIST__"
+
+	// sicScopeDepthMetaKey counts the scoped blocks currently executing
+	// in this WORK; it is absent outside any block.
+	sicScopeDepthMetaKey = "__SIC_SCOPE_DEPTH"
+)
+
+// execScopedBlock executes an IF/WHILE body like execBlock, but with
+// block scoping: sigils *created* in the body are scrubbed when it exits
+// (on any path), unless declared with LET OUTER / LET PERSIST. Sigils
+// that already existed keep whatever the body assigned to them. Runtime
+// metadata (e.g. raised OMEN flags) is never scrubbed.
+//
+// PERSIST markers are dropped once their sigil is gone, and all of them
+// when the outermost block exits: by then the sigils they kept live at
+// WORK level, and a stale marker would stop a later block from scrubbing
+// a new sigil of the same name.
+func execScopedBlock(prog *Program, tokens []Token, sigils sigilTable) error {
+	before := make(map[string]bool, len(sigils))
+	for k := range sigils {
+		before[k] = true
+	}
+
+	depth, _ := strconv.Atoi(sigils[sicScopeDepthMetaKey])
+	sigils[sicScopeDepthMetaKey] = strconv.Itoa(depth + 1)
+
+	defer func() {
+		for k := range sigils {
+			if before[k] || strings.HasPrefix(k, "__") {
+				continue
+			}
+			if _, ok := sigils[sicPersistMetaPrefix+k]; ok {
+				continue
+			}
+			delete(sigils, k)
+			delete(sigils, sicInvisibleMetaPrefix+k)
+		}
+		for k := range sigils {
+			name, ok := strings.CutPrefix(k, sicPersistMetaPrefix)
+			if !ok {
+				continue
+			}
+			if _, live := sigils[name]; !live || depth == 0 {
+				delete(sigils, k)
+			}
+		}
+		if depth == 0 {
+			delete(sigils, sicScopeDepthMetaKey)
+		} else {
+			sigils[sicScopeDepthMetaKey] = strconv.Itoa(depth)
+		}
+	}()
+
+	return execBlock(prog, tokens, sigils)
+}
+
 // execBlock executes a slice of tokens as if it were a mini-Work.
 func execBlock(prog *Program, tokens []Token, sigils sigilTable) error {
 	w := &WorkDecl{
@@ -2467,10 +4343,195 @@ func execBlockWithOmen(prog *Program, tokens []Token, sigils sigilTable) (*omenE
 	return nil, err
 }
 
-// ---------------- OMEN statements ----------------
-
-// RAISE OMEN "network_failure".
-func execRaiseOmen(tokens []Token, i int, sigils sigilTable) (int, error) {
+// ---------------- DO blocks ----------------
+//
+// DO:
+//   LET SIGIL tmp BE 1.
+//   SAY: tmp.
+// ENDDO.
+//
+// An anonymous block: the body runs once, with IF/WHILE block scoping,
+// so sigils it creates are gone after ENDDO unless declared LET OUTER.
+
+// isDoBlockStart reports whether tokens[i] opens a DO block: DO followed
+// by ':' at the start of a statement. The DO of WHILE <cond> DO: follows
+// its condition and so never matches.
+func isDoBlockStart(tokens []Token, i int) bool {
+	if !isWord(tokens[i], "DO") || i+1 >= len(tokens) || tokens[i+1].Type != TOK_COLON {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	switch tokens[i-1].Type {
+	case TOK_NEWLINE, TOK_DOT, TOK_COLON:
+		return true
+	}
+	return false
+}
+
+// execDoBlock executes: DO: <body> ENDDO.
+func execDoBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "DO"
+	i += 2                // DO + COLON
+
+	// Find matching ENDDO, respecting nesting.
+	bodyStart := i
+	endPos := -1
+	depth := 1
+	for j := i; j < len(tokens); j++ {
+		if isDoBlockStart(tokens, j) {
+			depth++
+		} else if isWord(tokens[j], "ENDDO") {
+			depth--
+			if depth == 0 {
+				endPos = j
+				break
+			}
+		}
+	}
+	if endPos == -1 {
+		return i, fmt.Errorf("DO: unmatched ENDDO for DO at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+
+	if err := execScopedBlock(prog, tokens[bodyStart:endPos], sigils); err != nil {
+		return endPos, err
+	}
+	return consumeTerminator(tokens, endPos+1), nil
+}
+
+// ---------------- WITH TIMEOUT ----------------
+//
+// WITH TIMEOUT 5:
+//   WHILE busy DO: ... ENDWHILE.
+// ENDTIMEOUT.
+//
+// Runs the body like a DO block under a deadline. There is no preemption:
+// the deadline is checked cooperatively at each WHILE iteration and by
+// SLEEP (which sleeps at most until the deadline), including inside
+// SUMMONed WORKs. Once it has passed, the check raises the catchable OMEN
+// "timeout". Nested timeouts keep the earlier of the two deadlines.
+
+// sicDeadlineMetaKey holds the active deadline as Unix nanoseconds. It is
+// not work-local, so cloneVisibleSigils carries it into SUMMONed WORKs.
+const sicDeadlineMetaKey = "__SIC_DEADLINE"
+
+// activeDeadline returns the deadline set by an enclosing WITH TIMEOUT.
+func activeDeadline(sigils sigilTable) (time.Time, bool) {
+	raw, ok := sigils[sicDeadlineMetaKey]
+	if !ok {
+		return time.Time{}, false
+	}
+	ns, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, ns), true
+}
+
+// checkDeadline returns the "timeout" OMEN once the active deadline has passed.
+func checkDeadline(sigils sigilTable) error {
+	if dl, ok := activeDeadline(sigils); ok && !sicNow().Before(dl) {
+		return &omenError{name: "timeout"}
+	}
+	return nil
+}
+
+// execWithTimeout executes: WITH TIMEOUT <seconds> [SECONDS]: <body> ENDTIMEOUT.
+func execWithTimeout(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // WITH
+	i += 2                // WITH + TIMEOUT
+
+	exprStart := i
+	for i < len(tokens) &&
+		tokens[i].Type != TOK_COLON &&
+		tokens[i].Type != TOK_NEWLINE &&
+		tokens[i].Type != TOK_SECONDS &&
+		!isWord(tokens[i], "SECONDS") {
+		i++
+	}
+	if exprStart == i {
+		return i, fmt.Errorf("WITH TIMEOUT: expected seconds after TIMEOUT at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	idx := 0
+	v, err := parseOr(prog, normalizeExprTokens(tokens[exprStart:i]), &idx, sigils)
+	if err != nil {
+		return i, err
+	}
+	secs, ok := v.asFloat()
+	if !ok || math.IsNaN(secs) || math.IsInf(secs, 0) || secs <= 0 {
+		return i, fmt.Errorf("WITH TIMEOUT: TIMEOUT must be a positive number of seconds at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	if i < len(tokens) && (tokens[i].Type == TOK_SECONDS || isWord(tokens[i], "SECONDS")) {
+		i++
+	}
+	if i >= len(tokens) || tokens[i].Type != TOK_COLON {
+		return i, fmt.Errorf("WITH TIMEOUT: expected ':' after TIMEOUT seconds at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	i++
+
+	// Find matching ENDTIMEOUT, respecting nesting.
+	bodyStart := i
+	endPos := -1
+	depth := 1
+	for j := i; j < len(tokens); j++ {
+		if isWithTimeoutStart(tokens, j) {
+			depth++
+		} else if isWord(tokens[j], "ENDTIMEOUT") {
+			depth--
+			if depth == 0 {
+				endPos = j
+				break
+			}
+		}
+	}
+	if endPos == -1 {
+		return i, fmt.Errorf("WITH TIMEOUT: unmatched ENDTIMEOUT for WITH TIMEOUT at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+
+	deadline := sicNow().Add(time.Duration(secs * float64(time.Second)))
+	prev, hadPrev := sigils[sicDeadlineMetaKey]
+	if outer, ok := activeDeadline(sigils); ok && outer.Before(deadline) {
+		deadline = outer
+	}
+	sigils[sicDeadlineMetaKey] = strconv.FormatInt(deadline.UnixNano(), 10)
+	err = execScopedBlock(prog, tokens[bodyStart:endPos], sigils)
+	if hadPrev {
+		sigils[sicDeadlineMetaKey] = prev
+	} else {
+		delete(sigils, sicDeadlineMetaKey)
+	}
+	if err != nil {
+		return endPos, err
+	}
+	return consumeTerminator(tokens, endPos+1), nil
+}
+
+// isWithTimeoutStart reports whether tokens[i] opens WITH TIMEOUT at the
+// start of a statement (SUMMON ... WITH SIGIL never matches).
+func isWithTimeoutStart(tokens []Token, i int) bool {
+	if tokens[i].Type != TOK_WITH || i+1 >= len(tokens) || !isWord(tokens[i+1], "TIMEOUT") {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	switch tokens[i-1].Type {
+	case TOK_NEWLINE, TOK_DOT, TOK_COLON:
+		return true
+	}
+	return false
+}
+
+// ---------------- OMEN statements ----------------
+
+// RAISE OMEN "network_failure".
+func execRaiseOmen(tokens []Token, i int, sigils sigilTable) (int, error) {
 	startTok := tokens[i] // RAISE
 	i++
 
@@ -2496,9 +4557,7 @@ func execRaiseOmen(tokens []Token, i int, sigils sigilTable) (int, error) {
 		tokens[i].Type != TOK_ENDWORK {
 		i++
 	}
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
 	// Mark the OMEN as present (no longer a fatal runtime error here).
 	raiseOmen(sigils, omenName)
@@ -2535,9 +4594,7 @@ func execFallsToRuin(prog *Program, tokens []Token, i int, sigils sigilTable) (i
 	fmt.Println("[SIC RUIN]", msg)
 	clearAllOmens(sigils)
 
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 	return i, nil
 }
 
@@ -2656,8 +4713,8 @@ func execOmenBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (int
 		return endPos + 1, nil
 	}
 
-	// If a different OMEN was raised, bubble it up.
-	if raised.name != omenName {
+	// If a different OMEN was raised, bubble it up. OMEN "*" catches any.
+	if omenName != sicOmenWildcard && raised.name != omenName {
 		return endPos + 1, raised
 	}
 
@@ -2683,14 +4740,234 @@ func execOmenBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (int
 		k++
 	}
 
-	// Execute the FALLS_TO_RUIN block.
-	if err := execBlock(prog, tokens[k:endPos], sigils); err != nil {
+	// Execute the FALLS_TO_RUIN block with the caught OMEN exposed as
+	// OMEN_NAME (and available to RERAISE.). Both get back whatever value
+	// they had before, so a caller's own OMEN_NAME sigil survives.
+	prevRuin, hadPrevRuin := sigils[sicRuinOmenMetaKey]
+	prevName, hadPrevName := sigils["OMEN_NAME"]
+	sigils[sicRuinOmenMetaKey] = raised.name
+	sigils["OMEN_NAME"] = raised.name
+	err = execBlock(prog, tokens[k:endPos], sigils)
+	if hadPrevName {
+		sigils["OMEN_NAME"] = prevName
+	} else {
+		delete(sigils, "OMEN_NAME")
+	}
+	if hadPrevRuin {
+		sigils[sicRuinOmenMetaKey] = prevRuin
+	} else {
+		delete(sigils, sicRuinOmenMetaKey)
+	}
+	if err != nil {
 		return endPos + 1, err
 	}
 
 	return endPos + 1, nil
 }
 
+// sicOmenWildcard is the OMEN block name that catches every OMEN.
+const sicOmenWildcard = "*"
+
+// sicRuinOmenMetaKey holds the OMEN being handled by the innermost
+// running FALLS_TO_RUIN block.
+const sicRuinOmenMetaKey = "__SIC_RUIN_OMEN"
+
+// RERAISE.
+// Re-throws the OMEN caught by the enclosing FALLS_TO_RUIN block, so a
+// handler can do partial cleanup and still let the failure propagate.
+func execReraise(tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "RERAISE"
+	i = consumeTerminator(tokens, i+1)
+
+	name, ok := sigils[sicRuinOmenMetaKey]
+	if !ok || name == "" {
+		return i, fmt.Errorf("RERAISE: no OMEN is being handled (use inside FALLS_TO_RUIN) at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	return i, &omenError{name: name}
+}
+
+// ---------------- RETRY ----------------
+//
+// RETRY 3 TIMES:
+//
+//	... flaky operations ...
+//
+// ENDRETRY.
+//
+// RETRY 3 TIMES BACKOFF 1 SECONDS:
+//
+//	...
+//
+// ENDRETRY.
+//
+// Each attempt runs the body inside an OMEN-style try. An attempt fails if
+// it returns an OMEN or RAISEs one. A failed attempt rolls sigils back to
+// the snapshot taken before the first attempt, waits BACKOFF seconds (if
+// given), and tries again. RETRY_ATTEMPT holds the 1-based attempt number.
+// If every attempt fails, the last OMEN is re-raised.
+func execRetryBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "RETRY"
+	i++
+
+	// Attempt count expression until TIMES
+	countStart := i
+	for i < len(tokens) &&
+		!isWord(tokens[i], "TIMES") &&
+		tokens[i].Type != TOK_COLON &&
+		tokens[i].Type != TOK_NEWLINE {
+		i++
+	}
+	if i >= len(tokens) || !isWord(tokens[i], "TIMES") || countStart == i {
+		return i, fmt.Errorf("RETRY: expected <count> TIMES at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	countTokens := tokens[countStart:i]
+	i++ // after TIMES
+
+	// Optional BACKOFF <seconds> [SECONDS]
+	var backoffTokens []Token
+	if i < len(tokens) && isWord(tokens[i], "BACKOFF") {
+		i++
+		backoffStart := i
+		for i < len(tokens) &&
+			tokens[i].Type != TOK_COLON &&
+			tokens[i].Type != TOK_NEWLINE &&
+			tokens[i].Type != TOK_SECONDS {
+			i++
+		}
+		if backoffStart == i {
+			return i, fmt.Errorf("RETRY: expected seconds after BACKOFF at %s:%d:%d",
+				startTok.File, startTok.Line, startTok.Column)
+		}
+		backoffTokens = tokens[backoffStart:i]
+		if i < len(tokens) && tokens[i].Type == TOK_SECONDS {
+			i++
+		}
+	}
+
+	if i >= len(tokens) || tokens[i].Type != TOK_COLON {
+		return i, fmt.Errorf("RETRY: expected COLON after header at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	i++ // after COLON
+
+	// Find matching ENDRETRY, respecting nesting.
+	bodyStart := i
+	endPos := -1
+	depth := 1
+	for j := i; j < len(tokens); j++ {
+		if isWord(tokens[j], "RETRY") {
+			depth++
+		} else if isWord(tokens[j], "ENDRETRY") {
+			depth--
+			if depth == 0 {
+				endPos = j
+				break
+			}
+		}
+	}
+	if endPos == -1 {
+		return i, fmt.Errorf("RETRY: unmatched ENDRETRY for RETRY at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	after := consumeTerminator(tokens, endPos+1)
+
+	// Evaluate header values once.
+	idx := 0
+	countVal, err := parseOr(prog, normalizeExprTokens(countTokens), &idx, sigils)
+	if err != nil {
+		return after, err
+	}
+	countF, ok := countVal.asFloat()
+	if !ok || countF < 1 || countF != float64(int64(countF)) {
+		return after, fmt.Errorf("RETRY: count must be a positive integer at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	attempts := int(countF)
+
+	backoff := 0.0
+	if backoffTokens != nil {
+		idx := 0
+		bv, err := parseOr(prog, normalizeExprTokens(backoffTokens), &idx, sigils)
+		if err != nil {
+			return after, err
+		}
+		b, ok := bv.asFloat()
+		if !ok || b < 0 {
+			return after, fmt.Errorf("RETRY: BACKOFF must be a non-negative number at %s:%d:%d",
+				startTok.File, startTok.Line, startTok.Column)
+		}
+		backoff = b
+	}
+
+	snapshot := cloneSigils(sigils)
+	var last *omenError
+
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			// Roll back to the pre-RETRY snapshot.
+			for k := range sigils {
+				delete(sigils, k)
+			}
+			for k, v := range snapshot {
+				sigils[k] = v
+			}
+			if backoff > 0 {
+				sicSleep(time.Duration(backoff * float64(time.Second)))
+			}
+		}
+		setSigil(sigils, "RETRY_ATTEMPT", strconv.Itoa(attempt))
+
+		sigils[sicOmenTryMetaKey] = "1"
+		raised, err := execBlockWithOmen(prog, tokens[bodyStart:endPos], sigils)
+		delete(sigils, sicOmenTryMetaKey)
+		if err != nil {
+			return after, err
+		}
+
+		if raised == nil {
+			if name, ok := newlyRaisedOmen(snapshot, sigils); ok {
+				raised = &omenError{name: name}
+			}
+		}
+		if raised == nil {
+			delete(sigils, "RETRY_ATTEMPT")
+			return after, nil
+		}
+		last = raised
+	}
+
+	// All attempts failed: roll back and re-raise the last OMEN.
+	for k := range sigils {
+		delete(sigils, k)
+	}
+	for k, v := range snapshot {
+		sigils[k] = v
+	}
+	return after, last
+}
+
+// newlyRaisedOmen returns the (alphabetically first) OMEN present in
+// after but not in before.
+func newlyRaisedOmen(before, after sigilTable) (string, bool) {
+	var names []string
+	for k := range after {
+		if !strings.HasPrefix(k, omenPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(k, omenPrefix)
+		if omenPresent(after, name) && !omenPresent(before, name) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", false
+	}
+	sort.Strings(names)
+	return names[0], true
+}
+
 // ---------------- EPHEMERAL block ----------------
 //
 // EPHEMERAL:
@@ -2818,9 +5095,13 @@ func execArcworkBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (
 		startTok.File, startTok.Line, startTok.Column)
 }
 
+// readArcOperand reads the integer operand of LOWER ... BY <operand>: a
+// whole-number literal or a sigil holding one. Errors carry the operand's
+// position.
 func readArcOperand(tokens []Token, i int, sigils sigilTable) (int64, int, error) {
 	if i >= len(tokens) {
-		return 0, i, fmt.Errorf("ARCWORK: missing operand")
+		t := tokens[len(tokens)-1]
+		return 0, i, fmt.Errorf("ARCWORK: missing operand at %s:%d:%d", t.File, t.Line, t.Column)
 	}
 	tok := tokens[i]
 
@@ -2828,7 +5109,8 @@ func readArcOperand(tokens []Token, i int, sigils sigilTable) (int64, int, error
 	case TOK_NUM:
 		v, err := strconv.ParseInt(tok.Lexeme, 10, 64)
 		if err != nil {
-			return 0, i + 1, fmt.Errorf("ARCWORK: invalid number %q", tok.Lexeme)
+			return 0, i + 1, fmt.Errorf("ARCWORK: operand %s is not a whole number at %s:%d:%d",
+				tok.Lexeme, tok.File, tok.Line, tok.Column)
 		}
 		return v, i + 1, nil
 
@@ -2838,15 +5120,11 @@ func readArcOperand(tokens []Token, i int, sigils sigilTable) (int64, int, error
 			return 0, i, fmt.Errorf("ARCWORK: expected SIGIL name after SIGIL at %s:%d:%d",
 				tokens[i-1].File, tokens[i-1].Line, tokens[i-1].Column)
 		}
-		name := tokens[i].Lexeme
-		v, err := getSigilInt(sigils, name)
-		return v, i + 1, err
+		return readArcSigilOperand(tokens[i], sigils, i+1)
 
 	case TOK_IDENT:
 		// bare SIGIL name
-		name := tok.Lexeme
-		v, err := getSigilInt(sigils, name)
-		return v, i + 1, err
+		return readArcSigilOperand(tok, sigils, i+1)
 
 	default:
 		return 0, i + 1, fmt.Errorf("ARCWORK: unexpected operand token %s at %s:%d:%d",
@@ -2854,6 +5132,15 @@ func readArcOperand(tokens []Token, i int, sigils sigilTable) (int64, int, error
 	}
 }
 
+func readArcSigilOperand(nameTok Token, sigils sigilTable, next int) (int64, int, error) {
+	v, err := getSigilInt(sigils, nameTok.Lexeme)
+	if err != nil {
+		return 0, next, fmt.Errorf("ARCWORK: operand %v at %s:%d:%d",
+			err, nameTok.File, nameTok.Line, nameTok.Column)
+	}
+	return v, next, nil
+}
+
 func execArcRaise(tokens []Token, i int, sigils sigilTable) (int, error) {
 	startTok := tokens[i] // TOK_RAISE
 	i++                   // after RAISE
@@ -2927,9 +5214,7 @@ func execArcRaise(tokens []Token, i int, sigils sigilTable) (int, error) {
 	}
 
 	// Optional DOT
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 
 	return i, nil
 }
@@ -2968,7 +5253,9 @@ func execArcLower(tokens []Token, i int, sigils sigilTable) (int, error) {
 
 	cur, err := getSigilInt(sigils, name)
 	if err != nil {
-		return i, err
+		// RAISE accepts decimals, so RAISE SIGIL x -0.5. is the float form.
+		return i, fmt.Errorf("ARCWORK LOWER: %v (LOWER needs whole numbers; RAISE takes decimals) at %s:%d:%d",
+			err, startTok.File, startTok.Line, startTok.Column)
 	}
 	setSigilInt(sigils, name, cur-delta)
 
@@ -2979,9 +5266,7 @@ func execArcLower(tokens []Token, i int, sigils sigilTable) (int, error) {
 		tokens[i].Type != TOK_ENDWORK {
 		i++
 	}
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 	return i, nil
 }
 
@@ -2993,6 +5278,9 @@ func execArcLower(tokens []Token, i int, sigils sigilTable) (int, error) {
 //	SUMMON WORK Beta  WITH SIGIL "two".
 //
 // ENDWEAVE.
+//
+// The SUMMONs run one after another in source order, in the caller's sigil
+// environment, with or without --serial-concurrency.
 func execWeaveBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
 	startTok := tokens[i]
 	i++ // after WEAVE
@@ -3050,6 +5338,8 @@ func execWeaveBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 // - Each SUMMON runs in parallel, bounded by a worker pool.
 // - Each task receives an isolated sigil environment (clone).
 // - First error is returned after all tasks complete.
+// - CHOIR TIMEOUT 5: bounds each task; a late task raises "choir_timeout".
+// - Under --serial-concurrency the tasks run one at a time, in order.
 func execChoirBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
 	startTok := tokens[i] // TOK_CHOIR
 	i++                   // after CHOIR
@@ -3105,6 +5395,38 @@ func execChoirBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 		choirHasSeal = true
 	}
 
+	// Optional: TIMEOUT <seconds> [SECONDS] (per-task deadline)
+	taskTimeout := time.Duration(0)
+	if i < len(tokens) && isWord(tokens[i], "TIMEOUT") {
+		timeoutTok := tokens[i]
+		i++
+		exprStart := i
+		for i < len(tokens) &&
+			tokens[i].Type != TOK_COLON &&
+			tokens[i].Type != TOK_NEWLINE &&
+			tokens[i].Type != TOK_SECONDS {
+			i++
+		}
+		if exprStart == i {
+			return i, fmt.Errorf("CHOIR: expected seconds after TIMEOUT at %s:%d:%d",
+				timeoutTok.File, timeoutTok.Line, timeoutTok.Column)
+		}
+		idx := 0
+		tv, err := parseOr(prog, normalizeExprTokens(tokens[exprStart:i]), &idx, sigils)
+		if err != nil {
+			return i, err
+		}
+		secs, ok := tv.asFloat()
+		if !ok || secs <= 0 {
+			return i, fmt.Errorf("CHOIR: TIMEOUT must be a positive number of seconds at %s:%d:%d",
+				timeoutTok.File, timeoutTok.Line, timeoutTok.Column)
+		}
+		taskTimeout = time.Duration(secs * float64(time.Second))
+		if i < len(tokens) && tokens[i].Type == TOK_SECONDS {
+			i++
+		}
+	}
+
 	// Optional colon: "CHOIR:" vs "CHOIR"
 	if i < len(tokens) && tokens[i].Type == TOK_COLON {
 		i++
@@ -3225,37 +5547,74 @@ func execChoirBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 			startIdx int
 		}
 
-		jobs := make(chan job, len(starts))
 		results := make([]error, len(starts))
 
-		var wg sync.WaitGroup
-		wg.Add(workers)
-
-		// Start workers
-		for w := 0; w < workers; w++ {
-			go func() {
-				defer wg.Done()
-				for jb := range jobs {
-					// Per-task env: clone the snapshot into a new environment
-					taskSigils := make(sigilTable, len(baseSnapshot)+8)
-					for k, v := range baseSnapshot {
-						taskSigils[k] = v
-					}
+		// runTask runs the SUMMON at startIdx in its own copy of the
+		// snapshot and records its error under order.
+		runTask := func(order, startIdx int) {
+			// Per-task env: clone the snapshot into a new environment
+			taskSigils := make(sigilTable, len(baseSnapshot)+8)
+			for k, v := range baseSnapshot {
+				taskSigils[k] = v
+			}
 
-					// Execute the SUMMON statement using the per-task environment
-					_, err := execSummonStmt(prog, tokens, jb.startIdx, taskSigils)
-					results[jb.order] = err
-				}
-			}()
-		}
+			// Execute the SUMMON statement using the per-task environment
+			if taskTimeout <= 0 {
+				_, err := execSummonStmt(prog, tokens, startIdx, taskSigils)
+				results[order] = err
+				return
+			}
 
-		// Enqueue jobs in source order
-		for idx, s := range starts {
-			jobs <- job{order: idx, startIdx: s}
+			// With TIMEOUT, a hung task is abandoned (there is no
+			// cancellation) and recorded as a choir_timeout OMEN.
+			done := make(chan error, 1)
+			go func(env sigilTable) {
+				_, err := execSummonStmt(prog, tokens, startIdx, env)
+				done <- err
+			}(taskSigils)
+
+			timer := time.NewTimer(taskTimeout)
+			select {
+			case err := <-done:
+				timer.Stop()
+				results[order] = err
+			case <-timer.C:
+				t := tokens[startIdx]
+				fmt.Fprintf(os.Stderr, "[SIC CHOIR] task at %s:%d:%d timed out after %s\n",
+					t.File, t.Line, t.Column, taskTimeout)
+				results[order] = &omenError{name: "choir_timeout"}
+			}
 		}
-		close(jobs)
 
-		wg.Wait()
+		if sicSerialConcurrency {
+			// --serial-concurrency: one task at a time, in source order.
+			for idx, s := range starts {
+				runTask(idx, s)
+			}
+		} else {
+			jobs := make(chan job, len(starts))
+
+			var wg sync.WaitGroup
+			wg.Add(workers)
+
+			// Start workers
+			for w := 0; w < workers; w++ {
+				go func() {
+					defer wg.Done()
+					for jb := range jobs {
+						runTask(jb.order, jb.startIdx)
+					}
+				}()
+			}
+
+			// Enqueue jobs in source order
+			for idx, s := range starts {
+				jobs <- job{order: idx, startIdx: s}
+			}
+			close(jobs)
+
+			wg.Wait()
+		}
 
 		// Deterministic error: first failing SUMMON in source order.
 		for _, err := range results {
@@ -3303,6 +5662,16 @@ func execChoirBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 	return k, nil
 }
 
+// sicSerialConcurrency makes CHOIR run its tasks one after another in
+// source order (still each in its own sigil copy), so output and timing
+// are the same on every run. WEAVE is always sequential.
+var sicSerialConcurrency bool
+
+// SetSerialConcurrency turns serial CHOIR execution on or off.
+func SetSerialConcurrency(on bool) {
+	sicSerialConcurrency = on
+}
+
 // choirWorkerCount reads a SIGIL override, else uses runtime default.
 // Suggested: SIGIL CHOIR_WORKERS BE 4.
 func choirWorkerCount(sigils sigilTable) int {
@@ -3319,6 +5688,23 @@ func choirWorkerCount(sigils sigilTable) int {
 	return n
 }
 
+// splitPathSegments splits a URL path on '/', dropping empty segments so
+// "/" has none and "/a/b/" is the same as "/a/b". At most
+// sicMaxPathSegments are returned.
+func splitPathSegments(path string) []string {
+	var out []string
+	for _, seg := range strings.Split(path, "/") {
+		if seg == "" {
+			continue
+		}
+		if len(out) >= sicMaxPathSegments {
+			break
+		}
+		out = append(out, seg)
+	}
+	return out
+}
+
 // injectRequestSigils populates SIGILs for the current HTTP request.
 // These are available inside any WORK run via ALTAR, or inline SEND BACK.
 //
@@ -3331,9 +5717,13 @@ func choirWorkerCount(sigils sigilTable) int {
 //
 // Additionally, each query parameter key is exposed as:
 //
-//	Q_<UPPERCASE_KEY>  -> first value
+//	Q_<UPPERCASE_KEY>        -> first value
+//	Q_<UPPERCASE_KEY>_COUNT  -> number of values (repeated keys)
+//	Q_<UPPERCASE_KEY>_<n>    -> n-th value, from 0
 //
 // e.g. ?name=Ada  => SIGIL Q_NAME BE "Ada"
+//
+//	?tag=a&tag=b => Q_TAG_COUNT "2", Q_TAG_0 "a", Q_TAG_1 "b"
 func injectRequestSigils(child sigilTable, r *http.Request) {
 	if child == nil || r == nil {
 		return
@@ -3350,6 +5740,16 @@ func injectRequestSigils(child sigilTable, r *http.Request) {
 		setRequestSigil(child, "REQUEST_QUERY", "")
 	}
 
+	// Path segments: PATH_0, PATH_1, ... and PATH_COUNT (invisible, bounded)
+	var segments []string
+	if r.URL != nil {
+		segments = splitPathSegments(r.URL.Path)
+	}
+	setRequestSigil(child, "PATH_COUNT", strconv.Itoa(len(segments)))
+	for n, seg := range segments {
+		setRequestSigil(child, fmt.Sprintf("PATH_%d", n), seg)
+	}
+
 	// Query params: Q_<KEY> (invisible, bounded)
 	if r.URL != nil {
 		q := r.URL.Query()
@@ -3367,30 +5767,55 @@ func injectRequestSigils(child sigilTable, r *http.Request) {
 			}
 			name := "Q_" + safeKey
 			setRequestSigil(child, name, vals[0])
+			if len(vals) > sicMaxQueryValues {
+				vals = vals[:sicMaxQueryValues]
+			}
+			setRequestSigil(child, name+"_COUNT", strconv.Itoa(len(vals)))
+			for n, v := range vals {
+				setRequestSigil(child, fmt.Sprintf("%s_%d", name, n), v)
+			}
 			added++
 		}
 	}
 
 	// Body (invisible, bounded)
+	//   REQUEST_BODY            -> body text (at most sicMaxRequestBodyBytes)
+	//   REQUEST_BODY_SIZE       -> byte count of REQUEST_BODY
+	//   REQUEST_BODY_TRUNCATED  -> "true" if the body was cut off
+	//   BODY_LIST, BODY_LIST_COUNT, BODY_LIST_<n> -> see injectBodyList
 	setRequestSigil(child, "REQUEST_BODY", "")
+	setRequestSigil(child, "REQUEST_BODY_SIZE", "0")
+	setRequestSigil(child, "REQUEST_BODY_TRUNCATED", "false")
 
 	if r.Body != nil {
 		// Read at most sicMaxRequestBodyBytes+1 so we can detect truncation
 		limited := io.LimitReader(r.Body, sicMaxRequestBodyBytes+1)
 		bodyBytes, err := io.ReadAll(limited)
+
+		// An upstream http.MaxBytesReader stops early with a MaxBytesError;
+		// keep what was read and report it as truncated.
+		truncated := false
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			truncated = true
+			err = nil
+		}
+
 		if err == nil {
-			truncated := false
 			if len(bodyBytes) > sicMaxRequestBodyBytes {
 				bodyBytes = bodyBytes[:sicMaxRequestBodyBytes]
 				truncated = true
 			}
 			bodyStr := string(bodyBytes)
 			if truncated {
-				// Optional: you can also set an extra invisible sigil if you want.
-				// setRequestSigil(child, "REQUEST_BODY_TRUNCATED", "true")
 				bodyStr += "\n"
 			}
 			setRequestSigil(child, "REQUEST_BODY", bodyStr)
+			setRequestSigil(child, "REQUEST_BODY_SIZE", strconv.Itoa(len(bodyBytes)))
+			setRequestSigil(child, "REQUEST_BODY_TRUNCATED", strconv.FormatBool(truncated))
+			if !truncated {
+				injectBodyList(child, bodyBytes)
+			}
 
 			// Rewind body so downstream handlers can still read it
 			r.Body = io.NopCloser(bytes.NewReader(bodyBytes))
@@ -3398,6 +5823,52 @@ func injectRequestSigils(child sigilTable, r *http.Request) {
 	}
 }
 
+// injectBodyList exposes a request body that is a JSON array the way
+// repeated query keys are exposed:
+//
+//	BODY_LIST        -> the array as JSON (usable with ITEM, IN, MAP, ...)
+//	BODY_LIST_COUNT  -> number of elements, so COUNT("BODY_LIST") works
+//	BODY_LIST_<n>    -> element n (at most sicMaxBodyListItems of them)
+//
+// Strings are taken as-is, numbers keep their JSON spelling, booleans are
+// "true"/"false" and null is "". Nested arrays and objects are not
+// flattened; they stay compact JSON text. Any other body sets nothing.
+func injectBodyList(child sigilTable, body []byte) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		return
+	}
+	dec := json.NewDecoder(bytes.NewReader(trimmed))
+	dec.UseNumber()
+	var items []any
+	if err := dec.Decode(&items); err != nil || dec.More() {
+		return
+	}
+
+	setRequestSigil(child, "BODY_LIST", string(trimmed))
+	setRequestSigil(child, "BODY_LIST_COUNT", strconv.Itoa(len(items)))
+	if len(items) > sicMaxBodyListItems {
+		items = items[:sicMaxBodyListItems]
+	}
+	for n, item := range items {
+		var v string
+		switch item := item.(type) {
+		case string:
+			v = item
+		case json.Number:
+			v = item.String()
+		case bool:
+			v = strconv.FormatBool(item)
+		case nil:
+			v = ""
+		default:
+			b, _ := json.Marshal(item)
+			v = string(b)
+		}
+		setRequestSigil(child, fmt.Sprintf("BODY_LIST_%d", n), v)
+	}
+}
+
 // ---------------- ALTAR Response Semantics ----------------
 //
 // Response contract (sigils, typically INVISIBLE):
@@ -3419,6 +5890,336 @@ const (
 	sicResponseHeaderPrefix     = "RESPONSE_HEADER_"
 )
 
+// ---------------- DENY ----------------
+//
+//	DENY 403 WITH "Forbidden".
+//	DENY 401.
+//
+// DENY ends the current request early: it unwinds out of every enclosing
+// block and SUMMON (it is not an OMEN, so OMEN blocks do not catch it) and
+// the ALTAR handler answers with the given status and body instead of the
+// WORK's answer. Without WITH the body is the status text. A guard WORK
+// summoned by a handler can therefore reject the request on its own.
+
+// denyError carries a DENY up to the ALTAR handler.
+type denyError struct {
+	status int
+	body   string
+	tok    Token
+}
+
+func (e *denyError) Error() string {
+	return fmt.Sprintf("%s %d outside an ALTAR request at %s:%d:%d",
+		strings.ToUpper(e.tok.Lexeme), e.status, e.tok.File, e.tok.Line, e.tok.Column)
+}
+
+// execDeny executes: DENY <status> [WITH <expr>].
+func execDeny(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "DENY"
+	i++
+
+	statusStart := i
+	for i < len(tokens) && tokens[i].Type != TOK_WITH &&
+		tokens[i].Type != TOK_DOT && tokens[i].Type != TOK_NEWLINE {
+		i++
+	}
+	if statusStart == i {
+		return i, fmt.Errorf("DENY: expected status code at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	raw, err := evalStringExpr(prog, tokens[statusStart:i], sigils)
+	if err != nil {
+		return i, err
+	}
+	status, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || status < 100 || status > 599 {
+		return i, fmt.Errorf("DENY: status must be an HTTP code in 100..599, got %q at %s:%d:%d",
+			raw, startTok.File, startTok.Line, startTok.Column)
+	}
+
+	body := http.StatusText(status)
+	if i < len(tokens) && tokens[i].Type == TOK_WITH {
+		i++
+		bodyStart := i
+		for i < len(tokens) && tokens[i].Type != TOK_DOT && tokens[i].Type != TOK_NEWLINE {
+			i++
+		}
+		body, err = evalStringExpr(prog, tokens[bodyStart:i], sigils)
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return consumeTerminator(tokens, i), &denyError{status: status, body: body, tok: startTok}
+}
+
+// ---------------- VALIDATE ----------------
+//
+//	VALIDATE SIGIL Q_AGE AS NUMBER ELSE RESPOND 400 "bad age".
+//	VALIDATE SIGIL Q_NAME ELSE RESPOND 400 "name is required".
+//
+// VALIDATE checks that a sigil is present and not blank and, with AS,
+// that it reads as TEXT (anything), NUMBER or BOOL ("true" / "false").
+// On failure it answers the request like DENY <status> WITH <body>.
+// It reads the value internally, so INVISIBLE request sigils (Q_*, ...)
+// can be checked without being exposed.
+
+// execValidate executes: VALIDATE SIGIL <name> [AS TEXT|NUMBER|BOOL]
+// ELSE RESPOND <status> [<body>].
+func execValidate(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "VALIDATE"
+	i++
+
+	if i < len(tokens) && tokens[i].Type == TOK_SIGIL {
+		i++
+	}
+	if i >= len(tokens) || !isSigilNameToken(tokens[i]) {
+		return i, fmt.Errorf("VALIDATE: expected SIGIL name at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	name := tokens[i].Lexeme
+	i++
+
+	kind := ""
+	if i < len(tokens) && tokens[i].Type == TOK_AS {
+		i++
+		if i >= len(tokens) {
+			return i, fmt.Errorf("VALIDATE: expected TEXT, NUMBER or BOOL after AS at %s:%d:%d",
+				startTok.File, startTok.Line, startTok.Column)
+		}
+		kind = strings.ToUpper(tokens[i].Lexeme)
+		if kind != "TEXT" && kind != "NUMBER" && kind != "BOOL" {
+			return i, fmt.Errorf("VALIDATE: expected TEXT, NUMBER or BOOL after AS, got %s at %s:%d:%d",
+				tokens[i].Lexeme, tokens[i].File, tokens[i].Line, tokens[i].Column)
+		}
+		i++
+	}
+
+	if i+1 >= len(tokens) || tokens[i].Type != TOK_ELSE || !isWord(tokens[i+1], "RESPOND") {
+		return i, fmt.Errorf("VALIDATE: expected ELSE RESPOND <status> after SIGIL %s at %s:%d:%d",
+			name, startTok.File, startTok.Line, startTok.Column)
+	}
+	i += 2
+
+	if i >= len(tokens) || tokens[i].Type != TOK_NUM {
+		return i, fmt.Errorf("VALIDATE: expected status code after RESPOND at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	status, err := strconv.Atoi(tokens[i].Lexeme)
+	if err != nil || status < 100 || status > 599 {
+		return i, fmt.Errorf("VALIDATE: status must be an HTTP code in 100..599, got %s at %s:%d:%d",
+			tokens[i].Lexeme, tokens[i].File, tokens[i].Line, tokens[i].Column)
+	}
+	i++
+
+	body := http.StatusText(status)
+	bodyStart := i
+	for i < len(tokens) && tokens[i].Type != TOK_DOT && tokens[i].Type != TOK_NEWLINE {
+		i++
+	}
+	if bodyStart < i {
+		body, err = evalStringExpr(prog, tokens[bodyStart:i], sigils)
+		if err != nil {
+			return i, err
+		}
+	}
+	i = consumeTerminator(tokens, i)
+
+	raw, ok := getInternalSigil(sigils, name)
+	if ok && validSigilKind(strings.TrimSpace(raw), kind) {
+		return i, nil
+	}
+	return i, &denyError{status: status, body: body, tok: startTok}
+}
+
+// validSigilKind reports whether a non-blank value reads as kind ("" and
+// TEXT accept anything). A NUMBER must be a finite decimal: ParseFloat
+// alone would also let "NaN", "Inf" and hex floats like "0x1p3" through.
+func validSigilKind(v, kind string) bool {
+	if v == "" {
+		return false
+	}
+	switch kind {
+	case "NUMBER":
+		if strings.ContainsAny(v, "xX") {
+			return false
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		return err == nil && !math.IsNaN(f) && !math.IsInf(f, 0)
+	case "BOOL":
+		return strings.EqualFold(v, "true") || strings.EqualFold(v, "false")
+	}
+	return true
+}
+
+// ---------------- HALT ----------------
+//
+//	HALT.
+//	HALT WITH CODE 3.
+//
+// HALT stops the whole scroll at once, from any depth of IF / WHILE /
+// SUMMON. It is not an OMEN: OMEN blocks, FALLS_TO_RUIN and RETRY do not
+// catch it. MAIN's answer is not printed. The process exits with CODE
+// (default 0).
+
+// haltError carries a HALT up to interpretProgramWith.
+type haltError struct {
+	code int
+	tok  Token
+}
+
+func (e *haltError) Error() string {
+	return fmt.Sprintf("HALT WITH CODE %d at %s:%d:%d", e.code, e.tok.File, e.tok.Line, e.tok.Column)
+}
+
+// execHalt executes: HALT [WITH CODE <expr>].
+func execHalt(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "HALT"
+	i++
+
+	code := 0
+	if i < len(tokens) && tokens[i].Type == TOK_WITH {
+		i++
+		if i >= len(tokens) || !isWord(tokens[i], "CODE") {
+			return i, fmt.Errorf("HALT: expected CODE after WITH at %s:%d:%d",
+				startTok.File, startTok.Line, startTok.Column)
+		}
+		i++
+
+		exprStart := i
+		for i < len(tokens) && tokens[i].Type != TOK_DOT && tokens[i].Type != TOK_NEWLINE {
+			i++
+		}
+		if exprStart == i {
+			return i, fmt.Errorf("HALT: expected exit code after CODE at %s:%d:%d",
+				startTok.File, startTok.Line, startTok.Column)
+		}
+		idx := 0
+		v, err := parseOr(prog, normalizeExprTokens(tokens[exprStart:i]), &idx, sigils)
+		if err != nil {
+			return i, err
+		}
+		f, ok := v.asFloat()
+		if !ok || f != float64(int(f)) || f < 0 || f > 255 {
+			return i, fmt.Errorf("HALT: exit code must be an integer in 0..255, got %q at %s:%d:%d",
+				v.String(), startTok.File, startTok.Line, startTok.Column)
+		}
+		code = int(f)
+	}
+
+	return consumeTerminator(tokens, i), &haltError{code: code, tok: startTok}
+}
+
+// ---------------- STREAM ----------------
+//
+//	STREAM "first chunk".
+//	STREAM row + "\n".
+//
+// STREAM sends a chunk of the response right away instead of buffering the
+// whole answer. The first STREAM commits the status and headers (from
+// RESPONSE_STATUS / RESPONSE_HEADER_* at that point); each chunk is flushed
+// to the client as it is written. Once a handler has streamed, its answer
+// and RESPONSE_BODY are not sent, and a later DENY or error can only cut
+// the response short. A WORK summoned by the handler may STREAM too.
+
+// sicStreamMetaKey holds the id of the current request's routeStream. It
+// is not work-local, so summoned WORKs inherit it.
+const sicStreamMetaKey = "__SIC_STREAM"
+
+// routeStream is the response writer of one in-flight ALTAR request.
+type routeStream struct {
+	w       http.ResponseWriter
+	path    string
+	started bool
+}
+
+var (
+	streamMu  sync.Mutex
+	streamSeq int64
+	streams   = map[string]*routeStream{}
+)
+
+// openStream registers w for the request handled with sigils and returns
+// the stream and a func that unregisters it.
+func openStream(w http.ResponseWriter, path string, sigils sigilTable) (*routeStream, func()) {
+	st := &routeStream{w: w, path: path}
+
+	streamMu.Lock()
+	streamSeq++
+	id := strconv.FormatInt(streamSeq, 10)
+	streams[id] = st
+	streamMu.Unlock()
+
+	sigils[sicStreamMetaKey] = id
+	return st, func() {
+		streamMu.Lock()
+		delete(streams, id)
+		streamMu.Unlock()
+	}
+}
+
+// streamStarted reports whether st has already sent part of the response.
+func streamStarted(st *routeStream) bool {
+	streamMu.Lock()
+	defer streamMu.Unlock()
+	return st.started
+}
+
+// execStream executes: STREAM <expr>.
+func execStream(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "STREAM"
+	i++
+
+	exprStart := i
+	for i < len(tokens) && tokens[i].Type != TOK_DOT && tokens[i].Type != TOK_NEWLINE {
+		i++
+	}
+	if exprStart == i {
+		return i, fmt.Errorf("STREAM: expected chunk expression at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	chunk, err := evalStringExpr(prog, tokens[exprStart:i], sigils)
+	if err != nil {
+		return i, err
+	}
+
+	id, _ := getInternalSigil(sigils, sicStreamMetaKey)
+	streamMu.Lock()
+	st := streams[id]
+	streamMu.Unlock()
+	if st == nil {
+		return i, fmt.Errorf("STREAM: only valid while handling an ALTAR request at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+
+	streamMu.Lock()
+	first := !st.started
+	st.started = true
+	streamMu.Unlock()
+
+	if first {
+		status, err := getResponseStatus(prog, st.path, sigils)
+		if err != nil {
+			streamMu.Lock()
+			st.started = false // nothing was written; let the handler answer 500
+			streamMu.Unlock()
+			return i, err
+		}
+		applyResponseHeaders(st.w, sigils)
+		st.w.Header().Set("Content-Type", routeContentType(st.path, chunk, sigils))
+		st.w.WriteHeader(status)
+	}
+	if _, err := st.w.Write([]byte(chunk)); err != nil {
+		return i, fmt.Errorf("STREAM: client went away: %v at %s:%d:%d",
+			err, startTok.File, startTok.Line, startTok.Column)
+	}
+	if f, ok := st.w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	return consumeTerminator(tokens, i), nil
+}
+
 // getInternalSigil fetches a sigil value even if it is invisible.
 // (Invisibility is a user-level semantic, not a runtime internal read barrier.)
 func getInternalSigil(sigils sigilTable, name string) (string, bool) {
@@ -3438,26 +6239,31 @@ func pickResponseBody(computed string, sigils sigilTable) string {
 }
 
 // getResponseStatus reads RESPONSE_STATUS as an int in [100..599]. Defaults to 200.
-func getResponseStatus(sigils sigilTable) int {
+// A value that is set but invalid is reported rather than silently dropped:
+// WEAK scrolls warn on stderr and fall back to 200, SCROLL STRONG fails.
+func getResponseStatus(prog *Program, path string, sigils sigilTable) (int, error) {
 	raw, ok := getInternalSigil(sigils, sicResponseStatusSigil)
 	if !ok || strings.TrimSpace(raw) == "" {
-		return http.StatusOK
+		return http.StatusOK, nil
 	}
 
 	n, err := strconv.Atoi(strings.TrimSpace(raw))
-	if err != nil {
-		return http.StatusOK
+	if err == nil && n >= 100 && n <= 599 {
+		return n, nil
 	}
-	if n < 100 || n > 599 {
-		return http.StatusOK
+
+	msg := fmt.Sprintf("route %s sets %s to %q, which is not an HTTP status in 100..599",
+		path, sicResponseStatusSigil, raw)
+	if prog.IsStrong() {
+		return 0, fmt.Errorf("ALTAR: %s (SCROLL STRONG)", msg)
 	}
-	return n
+	fmt.Fprintf(os.Stderr, "[SIC ALTAR] warning: %s; answering 200\n", msg)
+	return http.StatusOK, nil
 }
 
-// chooseContentType returns RESPONSE_CONTENT_TYPE if set; otherwise fallback.
-// You can optionally auto-pick JSON if body "looks like json", but this keeps it deterministic.
 // chooseContentType returns a safe Content-Type.
 // Priority: RESPONSE_CONTENT_TYPE sigil -> fallback -> default.
+// It never sniffs the body, so the choice stays deterministic.
 func chooseContentType(fallback string, body string, sigils sigilTable) string {
 	// 1) If runtime/internal sigil sets it, prefer that (but sanitize + validate).
 	if v, ok := getInternalSigil(sigils, sicResponseContentTypeSigil); ok {
@@ -3476,6 +6282,67 @@ func chooseContentType(fallback string, body string, sigils sigilTable) string {
 	return "text/plain; charset=utf-8"
 }
 
+const (
+	sicContentTypeText = "text/plain; charset=utf-8"
+	sicContentTypeJSON = "application/json; charset=utf-8"
+)
+
+// routeContentType picks the Content-Type for an ALTAR route response.
+//
+// Priority:
+//  1. RESPONSE_CONTENT_TYPE sigil (if valid)
+//  2. application/json for routes whose path ends in ".json"
+//  3. text/plain; charset=utf-8
+//
+// If a ".json" route overrides the type with something that is not JSON,
+// the response is still sent as requested but a warning is logged.
+func routeContentType(path string, body string, sigils sigilTable) string {
+	fallback := sicContentTypeText
+	jsonRoute := strings.HasSuffix(strings.ToLower(path), ".json")
+	if jsonRoute {
+		fallback = sicContentTypeJSON
+	}
+
+	ct := chooseContentType(fallback, body, sigils)
+	if jsonRoute && !isJSONContentType(ct) {
+		fmt.Fprintf(os.Stderr, "[SIC ALTAR] warning: route %s ends in .json but responds with Content-Type %q\n",
+			path, ct)
+	}
+	return ct
+}
+
+// warnUnescapedHTML logs a warning when a text/html response contains
+// request-derived text (Q_*, PATH_*, REQUEST_*) verbatim even though it
+// holds HTML metacharacters, i.e. it was echoed without HTML_ESCAPE.
+// The body is sent unchanged; escaping stays the handler's decision.
+func warnUnescapedHTML(path, ct, body string, sigils sigilTable) {
+	mediaType, _, err := mime.ParseMediaType(ct)
+	if err != nil || mediaType != "text/html" {
+		return
+	}
+	for k, v := range sigils {
+		if !strings.HasPrefix(k, "Q_") && !strings.HasPrefix(k, "PATH_") &&
+			!strings.HasPrefix(k, "REQUEST_") {
+			continue
+		}
+		if !strings.ContainsAny(v, `<>&'"`) || !strings.Contains(body, v) {
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "[SIC ALTAR] warning: route %s echoes %s into text/html without HTML_ESCAPE\n",
+			path, k)
+		return
+	}
+}
+
+// isJSONContentType reports whether ct is application/json or a +json type.
+func isJSONContentType(ct string) bool {
+	mediaType, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
 func sanitizeAndValidateContentType(raw string) string {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
@@ -3507,42 +6374,316 @@ func sanitizeAndValidateContentType(raw string) string {
 			params["charset"] = "utf-8"
 		}
 	}
-
-	// Recompose canonical form
-	return mime.FormatMediaType(mediaType, params)
+
+	// Recompose canonical form
+	return mime.FormatMediaType(mediaType, params)
+}
+
+// applyResponseHeaders scans RESPONSE_HEADER_* sigils and sets them on the response.
+// Header name rules:
+// - "RESPONSE_HEADER_X_Foo" -> "X-Foo"
+// - underscores are converted to hyphens
+func applyResponseHeaders(w http.ResponseWriter, sigils sigilTable) {
+	if w == nil || sigils == nil {
+		return
+	}
+
+	for k, v := range sigils {
+		if strings.HasPrefix(k, sicInvisibleMetaPrefix) {
+			continue
+		}
+		if !strings.HasPrefix(k, sicResponseHeaderPrefix) {
+			continue
+		}
+
+		name := strings.TrimPrefix(k, sicResponseHeaderPrefix)
+		name = strings.ReplaceAll(name, "_", "-")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if isDisallowedResponseHeader(name) {
+			// silently ignore (or you could raise an OMEN later)
+			continue
+		}
+
+		w.Header().Set(name, cleanHeaderValue(v))
+	}
+}
+
+// sicRouteMethodAny is the ROUTE method that matches every HTTP verb.
+const sicRouteMethodAny = "ANY"
+
+// normalizeRouteMethod returns the uppercased HTTP method for a ROUTE
+// method token. Accepts the GET/POST/PUT/DELETE keywords and ANY.
+func normalizeRouteMethod(tok Token) (string, bool) {
+	switch tok.Type {
+	case TOK_GET, TOK_POST, TOK_PUT, TOK_DELETE, TOK_IDENT:
+	default:
+		return "", false
+	}
+
+	m := strings.ToUpper(strings.TrimSpace(tok.Lexeme))
+	switch m {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, sicRouteMethodAny:
+		return m, true
+	}
+	return "", false
+}
+
+// routeMethodMatches reports whether a request method satisfies a ROUTE
+// method (already normalized by normalizeRouteMethod).
+func routeMethodMatches(routeMethod, reqMethod string) bool {
+	if routeMethod == sicRouteMethodAny {
+		return true
+	}
+	return routeMethod == strings.ToUpper(reqMethod)
+}
+
+// isAltarAddrEnd reports whether tokens[i] ends the ALTAR address
+// (header COLON, SEAL/SEALED modifiers, NEWLINE, or end of tokens).
+func isAltarAddrEnd(tokens []Token, i int) bool {
+	if i >= len(tokens) {
+		return true
+	}
+	switch tokens[i].Type {
+	case TOK_COLON, TOK_NEWLINE, TOK_SEAL, TOK_SEALED, TOK_WITH:
+		return true
+	case TOK_IDENT:
+		return strings.EqualFold(tokens[i].Lexeme, "SEAL") ||
+			strings.EqualFold(tokens[i].Lexeme, "SEALED") ||
+			strings.EqualFold(tokens[i].Lexeme, "ON")
+	}
+	return false
+}
+
+// normalizeAltarAddr validates an ALTAR address and returns it in
+// host:port form. A bare port ("15080") becomes ":15080".
+func normalizeAltarAddr(raw string) (string, error) {
+	addr := strings.TrimSpace(raw)
+	if addr == "" {
+		return "", fmt.Errorf("empty address")
+	}
+
+	if path, ok := strings.CutPrefix(addr, sicUnixAddrPrefix); ok {
+		if strings.TrimSpace(path) == "" {
+			return "", fmt.Errorf("empty Unix socket path in address %q", raw)
+		}
+		return addr, nil
+	}
+
+	if _, err := strconv.Atoi(addr); err == nil {
+		addr = ":" + addr
+	}
+
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", fmt.Errorf("invalid address %q", raw)
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 0 || n > 65535 {
+		return "", fmt.Errorf("invalid port in address %q", raw)
+	}
+	return addr, nil
+}
+
+// validateRoutePath rejects paths the mux would never match: empty ones
+// and ones without a leading "/" (the error suggests the fixed path).
+func validateRoutePath(path string) error {
+	if strings.TrimSpace(path) == "" {
+		return fmt.Errorf("path is empty; use \"/\" for the root")
+	}
+	if !strings.HasPrefix(path, "/") {
+		return fmt.Errorf("path %q must start with \"/\"; did you mean %q?", path, "/"+path)
+	}
+	return nil
+}
+
+// pendingRoute is a parsed ROUTE waiting for its ALTAR block to finish.
+type pendingRoute struct {
+	key     string // "METHOD /path"
+	path    string
+	handler http.HandlerFunc
+}
+
+// commitAltarRoutes registers the routes of a fully parsed ALTAR block and
+// starts the server on first use. fresh means srv was created by this block
+// and still has to be published as the global ALTAR.
+func commitAltarRoutes(srv *altarServer, fresh bool, routes []pendingRoute) error {
+	altarMu.Lock()
+	defer altarMu.Unlock()
+
+	if fresh {
+		if globalAltar != nil {
+			return fmt.Errorf("ALTAR: server already bound to %s, cannot rebind to %s",
+				globalAltar.addr, srv.addr)
+		}
+		globalAltar = srv
+	}
+
+	for _, pr := range routes {
+		if srv.registered[pr.key] {
+			return fmt.Errorf("ALTAR: duplicate route %s", pr.key)
+		}
+	}
+	for _, pr := range routes {
+		srv.registered[pr.key] = true
+		srv.mux.HandleFunc(pr.path, pr.handler)
+	}
+
+	// Start HTTP server once
+	if !srv.started {
+		srv.started = true
+		if path, ok := strings.CutPrefix(srv.addr, sicUnixAddrPrefix); ok {
+			return serveAltarUnix(srv, path)
+		}
+		go func(s *altarServer) {
+			var err error
+			if s.certFile != "" {
+				fmt.Fprintf(os.Stderr, "[SIC ALTAR] HTTPS server listening on %s\n", s.addr)
+				err = http.ListenAndServeTLS(s.addr, s.certFile, s.keyFile, s.mux)
+			} else {
+				fmt.Fprintf(os.Stderr, "[SIC ALTAR] HTTP server listening on %s\n", s.addr)
+				err = http.ListenAndServe(s.addr, s.mux)
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "[SIC ALTAR] server error: %v\n", err)
+			}
+		}(srv)
+	}
+	return nil
+}
+
+// parseAltarTLS parses CERT <file> KEY <file> after an ALTAR header's
+// WITH and checks the pair can be loaded, so a bad certificate fails at
+// startup rather than on the first request.
+func parseAltarTLS(prog *Program, tokens []Token, i int, withTok Token, sigils sigilTable) (string, string, int, error) {
+	files := map[string]string{}
+	for _, word := range []string{"CERT", "KEY"} {
+		if i >= len(tokens) || !isWord(tokens[i], word) {
+			return "", "", i, fmt.Errorf("ALTAR: expected WITH CERT <file> KEY <file> at %s:%d:%d",
+				withTok.File, withTok.Line, withTok.Column)
+		}
+		i++
+		exprStart := i
+		for i < len(tokens) && !isWord(tokens[i], "KEY") && !isAltarAddrEnd(tokens, i) &&
+			!isWord(tokens[i], "ON") {
+			i++
+		}
+		if exprStart == i {
+			return "", "", i, fmt.Errorf("ALTAR: expected file after %s at %s:%d:%d",
+				word, withTok.File, withTok.Line, withTok.Column)
+		}
+		val, err := evalStringExpr(prog, tokens[exprStart:i], sigils)
+		if err != nil {
+			return "", "", i, err
+		}
+		files[word] = val
+	}
+
+	if isSandboxed(prog) {
+		return "", "", i, fmt.Errorf("ALTAR: WITH CERT reads files, which PROFILE %q forbids at %s:%d:%d",
+			prog.Profile, withTok.File, withTok.Line, withTok.Column)
+	}
+	for _, word := range []string{"CERT", "KEY"} {
+		path := files[word]
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(filepath.Dir(withTok.File), path)
+		}
+		if _, err := os.Stat(path); err != nil {
+			return "", "", i, fmt.Errorf("ALTAR: %s file %s: %v at %s:%d:%d",
+				word, files[word], err, withTok.File, withTok.Line, withTok.Column)
+		}
+		files[word] = path
+	}
+	if _, err := tls.LoadX509KeyPair(files["CERT"], files["KEY"]); err != nil {
+		return "", "", i, fmt.Errorf("ALTAR: cannot load CERT/KEY pair: %v at %s:%d:%d",
+			err, withTok.File, withTok.Line, withTok.Column)
+	}
+	return files["CERT"], files["KEY"], i, nil
 }
 
-// applyResponseHeaders scans RESPONSE_HEADER_* sigils and sets them on the response.
-// Header name rules:
-// - "RESPONSE_HEADER_X_Foo" -> "X-Foo"
-// - underscores are converted to hyphens
-func applyResponseHeaders(w http.ResponseWriter, sigils sigilTable) {
-	if w == nil || sigils == nil {
-		return
-	}
+// sicUnixAddrPrefix marks an ALTAR address as a Unix domain socket:
+// ALTAR AT "unix:/tmp/sic.sock":
+const sicUnixAddrPrefix = "unix:"
 
-	for k, v := range sigils {
-		if strings.HasPrefix(k, sicInvisibleMetaPrefix) {
-			continue
+// serveAltarUnix starts srv on the Unix socket at path. A stale socket
+// file left by an earlier run is removed first; anything else at path is
+// an error. Called with altarMu held.
+func serveAltarUnix(srv *altarServer, path string) error {
+	if fi, err := os.Lstat(path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return fmt.Errorf("ALTAR: %s exists and is not a socket", path)
 		}
-		if !strings.HasPrefix(k, sicResponseHeaderPrefix) {
-			continue
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("ALTAR: cannot remove stale socket %s: %v", path, err)
 		}
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return fmt.Errorf("ALTAR: cannot listen on %s: %v", srv.addr, err)
+	}
+	srv.unixLn = ln
 
-		name := strings.TrimPrefix(k, sicResponseHeaderPrefix)
-		name = strings.ReplaceAll(name, "_", "-")
-		name = strings.TrimSpace(name)
-		if name == "" {
-			continue
+	go func(s *altarServer) {
+		fmt.Fprintf(os.Stderr, "[SIC ALTAR] HTTP server listening on %s\n", s.addr)
+		var err error
+		if s.certFile != "" {
+			err = http.ServeTLS(ln, s.mux, s.certFile, s.keyFile)
+		} else {
+			err = http.Serve(ln, s.mux)
+		}
+		if err != nil && !errors.Is(err, net.ErrClosed) {
+			fmt.Fprintf(os.Stderr, "[SIC ALTAR] server error: %v\n", err)
 		}
+	}(srv)
+	return nil
+}
 
-		if isDisallowedResponseHeader(name) {
-			// silently ignore (or you could raise an OMEN later)
-			continue
+// closeAltarUnixSocket stops a Unix-socket ALTAR when the scroll finishes;
+// closing the listener also removes the socket file.
+func closeAltarUnixSocket() {
+	altarMu.Lock()
+	defer altarMu.Unlock()
+	if globalAltar != nil && globalAltar.unixLn != nil {
+		_ = globalAltar.unixLn.Close()
+		globalAltar.unixLn = nil
+	}
+}
+
+// runAltarStartWork runs an ALTAR's ON START WORK on the caller's own
+// sigils, so whatever it binds is cloned into every request. OMENs pass
+// through unwrapped; other failures abort startup.
+func runAltarStartWork(prog *Program, name string, sigils sigilTable) error {
+	work := findWork(prog, name)
+	fmt.Printf("[SIC ALTAR] ON START WORK %s\n", name)
+	if _, err := execWork(prog, work, sigils, false); err != nil {
+		if _, ok := err.(*omenError); ok {
+			return err
 		}
+		return fmt.Errorf("ALTAR: ON START WORK %s failed, server not started: %v", name, err)
+	}
+	return nil
+}
 
-		w.Header().Set(name, cleanHeaderValue(v))
+// renderRouteList lists the routes registered on srv ("GET /hello"), sorted,
+// one per line or as a JSON array of strings. It reads srv.registered at
+// request time, so routes added by later ALTAR blocks show up too.
+func renderRouteList(srv *altarServer, asJSON bool) string {
+	altarMu.Lock()
+	keys := make([]string, 0, len(srv.registered))
+	for k := range srv.registered {
+		keys = append(keys, k)
+	}
+	altarMu.Unlock()
+	sort.Strings(keys)
+
+	if asJSON {
+		b, _ := json.Marshal(keys)
+		return string(b)
 	}
+	return strings.Join(keys, "\n")
 }
 
 // ---------------- ALTAR / ROUTE Canticle ----------------
@@ -3558,12 +6699,14 @@ func applyResponseHeaders(w http.ResponseWriter, sigils sigilTable) {
 //	ROUTE GET "/hello" TO WORK HELLO.
 //	ROUTE GET "/ok"    TO SEND BACK "OK".
 //	ROUTE GET "/sum"   TO SEND BACK "1 + 2 * 3".
+//	ROUTE GET "/__routes" TO LIST ROUTES.
 //
 // ENDALTAR.
 //
 // v1 semantics:
 // - Start (or reuse) an HTTP server at given addr.
 // - Register each ROUTE inside this ALTAR block.
+// - Setup is atomic: nothing is registered until the whole block parses.
 // execAltarBlock executes an ALTAR block.
 //
 // Supported header forms (all equivalent):
@@ -3577,8 +6720,18 @@ func applyResponseHeaders(w http.ResponseWriter, sigils sigilTable) {
 //	    SEALED SEAL "altar_key"
 //	    ROUTE ...
 //
+//	ALTAR AT :15081 ON START WORK INIT:
+//	ALTAR AT :8443 WITH CERT "cert.pem" KEY "key.pem":
+//
 // Rules:
 //   - SEAL/SEALED are header-only. If seen in the body, fail loudly.
+//   - ON START WORK <name> (inline or as a prelude line) runs that WORK
+//     once, on the caller's sigils, before the server starts listening;
+//     what it LETs is visible to every handler. An error aborts startup.
+//   - WITH CERT <file> KEY <file> (inline, right after the address) serves
+//     HTTPS. Relative paths resolve against the scroll's directory; both
+//     files must exist and form a valid pair, and PROFILE "SANDBOX" forbids
+//     it. Like ON START, only the ALTAR that starts the server may set it.
 //   - First bind may set a seal (if provided). Subsequent ALTAR blocks must
 //     present matching SEAL to modify routes once sealed.
 func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
@@ -3603,15 +6756,23 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 		return i, fmt.Errorf("ALTAR: expected port or address after AT")
 	}
 
+	// Optional PORT keyword: ALTAR AT PORT 15080:
+	if tokens[i].Type == TOK_PORT {
+		i++
+		if i >= len(tokens) {
+			return i, fmt.Errorf("ALTAR: expected port or address after PORT")
+		}
+	}
+
 	var addr string
 	tok := tokens[i]
 
-	switch tok.Type {
-	case TOK_STRING:
+	switch {
+	case tok.Type == TOK_STRING && isAltarAddrEnd(tokens, i+1):
 		addr = tok.Lexeme
 		i++
 
-	case TOK_COLON:
+	case tok.Type == TOK_COLON:
 		if i+1 >= len(tokens) || tokens[i+1].Type != TOK_NUM {
 			return i, fmt.Errorf("ALTAR: expected numeric port after ':' at %s:%d:%d",
 				tok.File, tok.Line, tok.Column)
@@ -3619,14 +6780,35 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 		addr = ":" + tokens[i+1].Lexeme
 		i += 2
 
-	case TOK_NUM:
+	case tok.Type == TOK_NUM && isAltarAddrEnd(tokens, i+1):
 		addr = ":" + tok.Lexeme
 		i++
 
 	default:
-		return i, fmt.Errorf("ALTAR: invalid address token %s at %s:%d:%d",
-			tok.Type, tok.File, tok.Line, tok.Column)
+		// Expression form: ALTAR AT PORT env_port:
+		// The expression runs until the header COLON / SEAL / SEALED / NEWLINE.
+		exprStart := i
+		for i < len(tokens) && !isAltarAddrEnd(tokens, i) {
+			i++
+		}
+		if exprStart == i {
+			return i, fmt.Errorf("ALTAR: invalid address token %s at %s:%d:%d",
+				tok.Type, tok.File, tok.Line, tok.Column)
+		}
+		val, err := evalStringExpr(prog, tokens[exprStart:i], sigils)
+		if err != nil {
+			return i, fmt.Errorf("ALTAR: cannot evaluate address at %s:%d:%d: %v",
+				tok.File, tok.Line, tok.Column, err)
+		}
+		addr = val
+	}
+
+	normAddr, err := normalizeAltarAddr(addr)
+	if err != nil {
+		return i, fmt.Errorf("ALTAR: %v at %s:%d:%d",
+			err, tok.File, tok.Line, tok.Column)
 	}
+	addr = normAddr
 
 	// ------------------------------------------------------------
 	// Parse SEAL/SEALED in TWO possible places:
@@ -3687,9 +6869,50 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 		return nil
 	}
 
+	// ON START WORK <name>
+	startWork := ""
+	parseOnStart := func() error {
+		onTok := tokens[i]
+		i++ // consume ON
+		if i+2 >= len(tokens) || !isWord(tokens[i], "START") || tokens[i+1].Type != TOK_WORK ||
+			tokens[i+2].Type != TOK_IDENT {
+			return fmt.Errorf("ALTAR: expected ON START WORK <name> at %s:%d:%d",
+				onTok.File, onTok.Line, onTok.Column)
+		}
+		if startWork != "" {
+			return fmt.Errorf("ALTAR: only one ON START WORK per ALTAR at %s:%d:%d",
+				onTok.File, onTok.Line, onTok.Column)
+		}
+		startWork = tokens[i+2].Lexeme
+		if findWork(prog, startWork) == nil {
+			return fmt.Errorf("ALTAR: ON START WORK %s is not defined at %s:%d:%d",
+				startWork, tokens[i+2].File, tokens[i+2].Line, tokens[i+2].Column)
+		}
+		i += 3
+
+		skipNewlines()
+		if i < len(tokens) && tokens[i].Type == TOK_DOT {
+			i++
+		}
+		return nil
+	}
+
 	// Optional inline header modifiers (before the first header colon)
 	skipNewlines()
 
+	// inline: optional WITH CERT <file> KEY <file>
+	certFile, keyFile := "", ""
+	if i < len(tokens) && tokens[i].Type == TOK_WITH {
+		withTok := tokens[i]
+		i++
+		var err error
+		certFile, keyFile, i, err = parseAltarTLS(prog, tokens, i, withTok, sigils)
+		if err != nil {
+			return i, err
+		}
+		skipNewlines()
+	}
+
 	// inline: optional SEALED
 	if i < len(tokens) && tokens[i].Type == TOK_SEALED {
 		declaredSealed = true
@@ -3705,6 +6928,13 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 		}
 	}
 
+	// inline: optional ON START WORK ...
+	if i < len(tokens) && isWord(tokens[i], "ON") {
+		if err := parseOnStart(); err != nil {
+			return i, err
+		}
+	}
+
 	// Optional colon that begins the ALTAR block header/body
 	skipNewlines()
 	if i < len(tokens) && tokens[i].Type == TOK_COLON {
@@ -3746,8 +6976,16 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 			continue
 		}
 
+		// ON START WORK line
+		if isWord(tokens[i], "ON") {
+			if err := parseOnStart(); err != nil {
+				return i, err
+			}
+			continue
+		}
+
 		// If it's neither ROUTE/ENDALTAR nor a header modifier, that's a hard error.
-		return i, fmt.Errorf("ALTAR: expected SEALED, SEAL, ROUTE, or ENDALTAR, got %s at %s:%d:%d",
+		return i, fmt.Errorf("ALTAR: expected SEALED, SEAL, ON START, ROUTE, or ENDALTAR, got %s at %s:%d:%d",
 			tokens[i].Type, tokens[i].File, tokens[i].Line, tokens[i].Column)
 	}
 	// If they wrote SEALED but forgot SEAL
@@ -3758,28 +6996,34 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 
 	fmt.Printf("[SIC ALTAR] ALTAR awakening at %s.\n", addr)
 
-	// ---------- init / start server (singleton) ----------
+	// ---------- init server (singleton) ----------
+	// A first bind only prepares the server here; it is published and
+	// started by commitAltarRoutes once every ROUTE has parsed, so a bad
+	// route leaves no half-configured server behind.
 	altarMu.Lock()
 
-	if globalAltar == nil {
-		globalAltar = &altarServer{
+	srv := globalAltar
+	fresh := false
+	if srv == nil {
+		srv = &altarServer{
 			addr:       addr,
 			mux:        http.NewServeMux(),
 			registered: make(map[string]bool),
 			seal:       "",
+			certFile:   certFile,
+			keyFile:    keyFile,
 		}
+		fresh = true
 		// First bind can seal the altar if a seal is provided
 		if hasSeal && strings.TrimSpace(sealVal) != "" {
-			globalAltar.seal = sealVal
+			srv.seal = sealVal
 		}
-	} else if globalAltar.addr != addr {
-		prev := globalAltar.addr
+	} else if srv.addr != addr {
+		prev := srv.addr
 		altarMu.Unlock()
 		return i, fmt.Errorf("ALTAR: server already bound to %s, cannot rebind to %s", prev, addr)
 	}
 
-	srv := globalAltar
-
 	// Enforce sealed altar: once sealed, any modification requires matching SEAL
 	if srv.seal != "" {
 		if !hasSeal || sealVal != srv.seal {
@@ -3788,18 +7032,30 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 		}
 	}
 
-	// Start HTTP server once
-	if !srv.started {
-		srv.started = true
-		go func(s *altarServer) {
-			fmt.Fprintf(os.Stderr, "[SIC ALTAR] HTTP server listening on %s\n", s.addr)
-			if err := http.ListenAndServe(s.addr, s.mux); err != nil {
-				fmt.Fprintf(os.Stderr, "[SIC ALTAR] server error: %v\n", err)
-			}
-		}(srv)
+	altarMu.Unlock()
+
+	if certFile != "" && !fresh {
+		return i, fmt.Errorf("ALTAR: WITH CERT needs the ALTAR that starts the server; %s is already running at %s:%d:%d",
+			addr, startTok.File, startTok.Line, startTok.Column)
 	}
 
-	altarMu.Unlock()
+	if startWork != "" && !fresh {
+		return i, fmt.Errorf("ALTAR: ON START WORK %s needs the ALTAR that starts the server; %s is already running at %s:%d:%d",
+			startWork, addr, startTok.File, startTok.Line, startTok.Column)
+	}
+
+	// Routes parsed so far; registered only when ENDALTAR is reached.
+	var pending []pendingRoute
+	isDuplicate := func(routeKey string) bool {
+		for _, pr := range pending {
+			if pr.key == routeKey {
+				return true
+			}
+		}
+		altarMu.Lock()
+		defer altarMu.Unlock()
+		return srv.registered[routeKey]
+	}
 
 	// ---------- parse ROUTE statements ----------
 	for i < len(tokens) {
@@ -3820,7 +7076,12 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 			if i < len(tokens) && tokens[i].Type == TOK_DOT {
 				i++
 			}
-			return i, nil
+			if startWork != "" {
+				if err := runAltarStartWork(prog, startWork, sigils); err != nil {
+					return i, err
+				}
+			}
+			return i, commitAltarRoutes(srv, fresh, pending)
 		}
 
 		if tok.Type != TOK_ROUTE {
@@ -3830,15 +7091,15 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 		i++ // after ROUTE
 
 		// HTTP method
-		if i >= len(tokens) ||
-			!((tokens[i].Type == TOK_GET) ||
-				(tokens[i].Type == TOK_POST) ||
-				(tokens[i].Type == TOK_PUT) ||
-				(tokens[i].Type == TOK_DELETE)) {
+		if i >= len(tokens) {
+			return i, fmt.Errorf("ALTAR: expected HTTP method after ROUTE at %s:%d:%d",
+				tokens[i-1].File, tokens[i-1].Line, tokens[i-1].Column)
+		}
+		method, ok := normalizeRouteMethod(tokens[i])
+		if !ok {
 			return i, fmt.Errorf("ALTAR: expected HTTP method after ROUTE at %s:%d:%d",
 				tokens[i-1].File, tokens[i-1].Line, tokens[i-1].Column)
 		}
-		method := tokens[i].Lexeme
 		i++
 
 		// Path
@@ -3885,6 +7146,11 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 			return i, fmt.Errorf("ALTAR: invalid path token %s at %s:%d:%d",
 				tokens[i].Type, tokens[i].File, tokens[i].Line, tokens[i].Column)
 		}
+		if err := validateRoutePath(path); err != nil {
+			pathTok := tokens[i-1]
+			return i, fmt.Errorf("ALTAR: ROUTE %s %v at %s:%d:%d",
+				method, err, pathTok.File, pathTok.Line, pathTok.Column)
+		}
 
 		// Expect IDENT "TO"
 		if i >= len(tokens) || !(tokens[i].Type == TOK_IDENT && strings.EqualFold(tokens[i].Lexeme, "TO")) {
@@ -3914,22 +7180,18 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 
 			fmt.Printf("[SIC ALTAR ROUTE] Route %s %s -> WORK %s\n", method, path, handlerName)
 
-			altarMu.Lock()
 			routeKey := method + " " + path
-			if srv.registered[routeKey] {
-				altarMu.Unlock()
+			if isDuplicate(routeKey) {
 				return i, fmt.Errorf("ALTAR: duplicate route %s", routeKey)
 			}
-			srv.registered[routeKey] = true
 
 			m := method
 			pth := path
 			h := handlerName
 			parent := sigils
-			mux := srv.mux
 
-			mux.HandleFunc(pth, func(w http.ResponseWriter, r *http.Request) {
-				if r.Method != m {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				if !routeMethodMatches(m, r.Method) {
 					http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
 					return
 				}
@@ -3942,8 +7204,25 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 				child := make(sigilTable)
 				cloneVisibleSigils(child, parent)
 				injectRequestSigils(child, r)
+				stream, closeStream := openStream(w, pth, child)
+				defer closeStream()
 
 				body, err := execWork(prog, work, child, true)
+				if streamStarted(stream) {
+					// Status and headers are gone; end the response here.
+					if err != nil {
+						fmt.Fprintf(sicStderr, "[SIC ALTAR] %s %s: stream cut short: %v\n", m, pth, err)
+					}
+					return
+				}
+				var deny *denyError
+				if errors.As(err, &deny) {
+					applyResponseHeaders(w, child)
+					w.Header().Set("Content-Type", routeContentType(pth, deny.body, child))
+					w.WriteHeader(deny.status)
+					_, _ = w.Write([]byte(deny.body + "\n"))
+					return
+				}
 				if err != nil {
 					http.Error(w, "internal error", http.StatusInternalServerError)
 					return
@@ -3955,15 +7234,21 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 				body = pickResponseBody(body, child)
 				applyResponseHeaders(w, child)
 
-				ct := chooseContentType("text/plain; charset=utf-8", body, child)
+				ct := routeContentType(pth, body, child)
 				w.Header().Set("Content-Type", ct)
+				warnUnescapedHTML(pth, ct, body, child)
 
-				status := getResponseStatus(child)
+				status, err := getResponseStatus(prog, pth, child)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "[SIC ALTAR] %v\n", err)
+					http.Error(w, "internal error", http.StatusInternalServerError)
+					return
+				}
 				w.WriteHeader(status)
 				_, _ = w.Write([]byte(body + "\n"))
-			})
+			}
+			pending = append(pending, pendingRoute{key: routeKey, path: pth, handler: handler})
 
-			altarMu.Unlock()
 			continue
 		}
 
@@ -4000,22 +7285,18 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 
 			fmt.Printf("[SIC ALTAR ROUTE] Route %s %s -> inline SEND BACK\n", method, path)
 
-			altarMu.Lock()
 			routeKey := method + " " + path
-			if srv.registered[routeKey] {
-				altarMu.Unlock()
+			if isDuplicate(routeKey) {
 				return i, fmt.Errorf("ALTAR: duplicate route %s", routeKey)
 			}
-			srv.registered[routeKey] = true
 
 			m := method
 			pth := path
 			exprCopy := exprTokens
 			parent := sigils
-			mux := srv.mux
 
-			mux.HandleFunc(pth, func(w http.ResponseWriter, r *http.Request) {
-				if r.Method != m {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				if !routeMethodMatches(m, r.Method) {
 					http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
 					return
 				}
@@ -4036,19 +7317,56 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 				val = pickResponseBody(val, child)
 				applyResponseHeaders(w, child)
 
-				ct := chooseContentType("text/plain; charset=utf-8", val, child)
+				ct := routeContentType(pth, val, child)
 				w.Header().Set("Content-Type", ct)
+				warnUnescapedHTML(pth, ct, val, child)
 
-				status := getResponseStatus(child)
+				status, err := getResponseStatus(prog, pth, child)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "[SIC ALTAR] %v\n", err)
+					http.Error(w, "internal error", http.StatusInternalServerError)
+					return
+				}
 				w.WriteHeader(status)
 				_, _ = w.Write([]byte(val + "\n"))
-			})
+			}
+			pending = append(pending, pendingRoute{key: routeKey, path: pth, handler: handler})
+
+			continue
+		}
+
+		// 3) ROUTE ... TO LIST ROUTES.
+		if isWord(tokens[i], "LIST") && i+1 < len(tokens) && isWord(tokens[i+1], "ROUTES") {
+			i += 2
+			if i < len(tokens) && tokens[i].Type == TOK_DOT {
+				i++
+			}
+
+			fmt.Printf("[SIC ALTAR ROUTE] Route %s %s -> LIST ROUTES\n", method, path)
+
+			routeKey := method + " " + path
+			if isDuplicate(routeKey) {
+				return i, fmt.Errorf("ALTAR: duplicate route %s", routeKey)
+			}
+
+			m := method
+			pth := path
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				if !routeMethodMatches(m, r.Method) {
+					http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+					return
+				}
+				body := renderRouteList(srv, strings.HasSuffix(strings.ToLower(pth), ".json"))
+				w.Header().Set("Content-Type", routeContentType(pth, body, nil))
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(body + "\n"))
+			}
+			pending = append(pending, pendingRoute{key: routeKey, path: pth, handler: handler})
 
-			altarMu.Unlock()
 			continue
 		}
 
-		return i, fmt.Errorf("ALTAR: expected WORK or SEND after TO at %s:%d:%d",
+		return i, fmt.Errorf("ALTAR: expected WORK, SEND or LIST ROUTES after TO at %s:%d:%d",
 			tokens[i].File, tokens[i].Line, tokens[i].Column)
 	}
 
@@ -4058,13 +7376,37 @@ func execAltarBlock(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 
 // SUMMON as a statement: ignore the returned value, keep side-effects.
 // Also consume trailing '.' or newline so WEAVE doesn't see stray tokens.
+//
+// Optional capture form:
+//
+//	SUMMON WORK X WITH SIGIL "a" YIELDS out.
+//
+// binds the WORK's answer into the parent sigil `out`. If the argument
+// came from an INVISIBLE sigil, the captured sigil is INVISIBLE too.
 func execSummonStmt(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
-	_, consumed, err := evalSummonExpr(prog, tokens, i, sigils)
+	val, tainted, consumed, err := evalSummonExprTainted(prog, tokens, i, sigils)
 	if err != nil {
 		return i + consumed, err
 	}
 	i += consumed
 
+	// Optional: YIELDS <sigil>
+	if i < len(tokens) && tokens[i].Type == TOK_YIELDS {
+		yieldsTok := tokens[i]
+		name, next, err := parseSigilTarget(tokens, i+1)
+		if err != nil {
+			return next, fmt.Errorf("SUMMON YIELDS: %v at %s:%d:%d",
+				err, yieldsTok.File, yieldsTok.Line, yieldsTok.Column)
+		}
+		i = next
+
+		if tainted {
+			setSigilInvisible(sigils, name, val)
+		} else {
+			setSigil(sigils, name, val)
+		}
+	}
+
 	// Consume any trailing junk up to DOT / NEWLINE / ENDWEAVE / ENDWORK
 	for i < len(tokens) &&
 		tokens[i].Type != TOK_DOT &&
@@ -4073,23 +7415,45 @@ func execSummonStmt(prog *Program, tokens []Token, i int, sigils sigilTable) (in
 		tokens[i].Type != TOK_ENDWORK {
 		i++
 	}
-	if i < len(tokens) && tokens[i].Type == TOK_DOT {
-		i++
-	}
+	i = consumeTerminator(tokens, i)
 	return i, nil
 }
 
+// matchingParen returns the index of the ')' closing tokens[open], or -1.
+func matchingParen(tokens []Token, open int) int {
+	depth := 0
+	for j := open; j < len(tokens); j++ {
+		switch tokens[j].Type {
+		case TOK_LPAREN:
+			depth++
+		case TOK_RPAREN:
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
 // ---------------- Expression evaluation (strings + SUMMON) ----------------
 
 // SUMMON expression:
 //
 //	SUMMON WORK GREETING WITH SIGIL "World"
 func evalSummonExpr(prog *Program, tokens []Token, start int, sigils sigilTable) (string, int, error) {
+	val, _, consumed, err := evalSummonExprTainted(prog, tokens, start, sigils)
+	return val, consumed, err
+}
+
+// evalSummonExprTainted is evalSummonExpr that also reports whether the
+// result depends on an INVISIBLE sigil passed as the argument.
+func evalSummonExprTainted(prog *Program, tokens []Token, start int, sigils sigilTable) (string, bool, int, error) {
 	i := start // tokens[i] is TOK_SUMMON
 
 	i++
 	if i >= len(tokens) || tokens[i].Type != TOK_WORK {
-		return "", 0, fmt.Errorf(
+		return "", false, 0, fmt.Errorf(
 			"SUMMON: expected WORK after SUMMON at %s:%d:%d",
 			tokens[i-1].File, tokens[i-1].Line, tokens[i-1].Column,
 		)
@@ -4097,7 +7461,7 @@ func evalSummonExpr(prog *Program, tokens []Token, start int, sigils sigilTable)
 	i++
 
 	if i >= len(tokens) || tokens[i].Type != TOK_IDENT {
-		return "", 0, fmt.Errorf(
+		return "", false, 0, fmt.Errorf(
 			"SUMMON: expected WORK name after WORK at %s:%d:%d",
 			tokens[i-1].File, tokens[i-1].Line, tokens[i-1].Column,
 		)
@@ -4105,51 +7469,86 @@ func evalSummonExpr(prog *Program, tokens []Token, start int, sigils sigilTable)
 	targetName := tokens[i].Lexeme
 	i++
 
-	argVal := ""
-	argFromSigil := ""       // if the arg was IDENT, track which sigil name
-	argWasInvisible := false // if argFromSigil was invisible, propagate invisibility
+	// summonArg is one positional argument after WITH.
+	type summonArg struct {
+		val       string
+		invisible bool // arg was an explicit reference to an INVISIBLE sigil
+		unused    bool // explicit UNUSED placeholder
+	}
+	var args []summonArg
 
 	sealVal := ""
 	hasSeal := false
 
-	// Optional: WITH SIGIL <arg>
+	// Optional: WITH SIGIL <arg> [, SIGIL <arg> ...]
 	if i < len(tokens) && tokens[i].Type == TOK_WITH {
 		i++
-		if i < len(tokens) && tokens[i].Type == TOK_SIGIL {
-			i++
-		}
+		for {
+			if i < len(tokens) && tokens[i].Type == TOK_SIGIL {
+				i++
+			}
 
-		if i >= len(tokens) {
-			return "", 0, fmt.Errorf("SUMMON: missing argument after WITH")
-		}
+			if i >= len(tokens) {
+				return "", false, 0, fmt.Errorf("SUMMON: missing argument after WITH")
+			}
 
-		switch tokens[i].Type {
-		case TOK_STRING:
-			argVal = tokens[i].Lexeme
-			i++
+			switch tokens[i].Type {
+			case TOK_STRING:
+				args = append(args, summonArg{val: tokens[i].Lexeme})
+				i++
 
-		case TOK_IDENT:
-			// Treat as sigil name (explicit reference = intentional)
-			argFromSigil = tokens[i].Lexeme
-			argVal, _ = getSigil(sigils, argFromSigil)
-			argWasInvisible = isInvisibleSigil(sigils, argFromSigil)
-			i++
+			case TOK_IDENT:
+				// Treat as sigil name (explicit reference = intentional)
+				name := tokens[i].Lexeme
+				v, _ := getSigil(sigils, name)
+				args = append(args, summonArg{val: v, invisible: isInvisibleSigil(sigils, name)})
+				i++
 
-		case TOK_UNUSED:
-			argVal = ""
-			i++
+			case TOK_UNUSED:
+				args = append(args, summonArg{unused: true})
+				i++
 
-		default:
-			return "", 0, fmt.Errorf(
-				"SUMMON: unsupported argument token %s at %s:%d:%d",
-				tokens[i].Type, tokens[i].File, tokens[i].Line, tokens[i].Column,
-			)
+			case TOK_LPAREN:
+				// Parenthesized expression: SIGIL (n + 1), SIGIL (SUMMON WORK X).
+				// Arguments are evaluated fully, left to right, before the
+				// target is looked up or invoked.
+				end := matchingParen(tokens, i)
+				if end == -1 {
+					return "", false, 0, fmt.Errorf("SUMMON: unclosed '(' in argument at %s:%d:%d",
+						tokens[i].File, tokens[i].Line, tokens[i].Column)
+				}
+				exprTokens := normalizeExprTokens(tokens[i+1 : end])
+				idx := 0
+				v, err := parseOr(prog, exprTokens, &idx, sigils)
+				if err != nil {
+					return "", false, 0, err
+				}
+				if idx != len(exprTokens) {
+					t := exprTokens[idx]
+					return "", false, 0, fmt.Errorf("SUMMON: unexpected %s in argument at %s:%d:%d",
+						t.Type, t.File, t.Line, t.Column)
+				}
+				args = append(args, summonArg{val: v.String(), invisible: v.tainted})
+				i = end + 1
+
+			default:
+				return "", false, 0, fmt.Errorf(
+					"SUMMON: unsupported argument token %s at %s:%d:%d",
+					tokens[i].Type, tokens[i].File, tokens[i].Line, tokens[i].Column,
+				)
+			}
+
+			if i < len(tokens) && tokens[i].Type == TOK_COMMA {
+				i++
+				continue
+			}
+			break
 		}
 	}
 
 	target := findWork(prog, targetName)
 	if target == nil {
-		return "", 0, fmt.Errorf("SUMMON: WORK %s not found", targetName)
+		return "", false, 0, fmt.Errorf("SUMMON: WORK %s not found", targetName)
 	}
 
 	// If SUMMON didn't specify SEAL explicitly, allow CHOIR default seal.
@@ -4168,7 +7567,7 @@ func evalSummonExpr(prog *Program, tokens []Token, start int, sigils sigilTable)
 		hasSeal = true
 
 		if i >= len(tokens) {
-			return "", 0, fmt.Errorf("SUMMON: missing SEAL value")
+			return "", false, 0, fmt.Errorf("SUMMON: missing SEAL value")
 		}
 
 		switch tokens[i].Type {
@@ -4183,31 +7582,53 @@ func evalSummonExpr(prog *Program, tokens []Token, start int, sigils sigilTable)
 		case TOK_SIGIL:
 			i++
 			if i >= len(tokens) || tokens[i].Type != TOK_IDENT {
-				return "", 0, fmt.Errorf("SUMMON: expected SIGIL name after SEAL SIGIL")
+				return "", false, 0, fmt.Errorf("SUMMON: expected SIGIL name after SEAL SIGIL")
 			}
 			sealVal, _ = getSigil(sigils, tokens[i].Lexeme)
 			i++
 
 		default:
-			return "", 0, fmt.Errorf("SUMMON: invalid SEAL value token %s", tokens[i].Type)
+			return "", false, 0, fmt.Errorf("SUMMON: invalid SEAL value token %s", tokens[i].Type)
 		}
 	}
 
+	// Arity check: every declared SIGIL param needs an argument (UNUSED
+	// fills a slot). A bare SUMMON with no WITH is allowed only when all
+	// params are UNUSED placeholders.
+	if len(args) == 0 {
+		for _, param := range target.SigilParams {
+			if param != "UNUSED" {
+				return "", false, 0, fmt.Errorf(
+					"SUMMON: WORK %s expects %d argument(s), got 0 at %s:%d:%d",
+					target.Name, len(target.SigilParams),
+					tokens[start].File, tokens[start].Line, tokens[start].Column,
+				)
+			}
+		}
+	} else if len(args) != len(target.SigilParams) {
+		return "", false, 0, fmt.Errorf(
+			"SUMMON: WORK %s expects %d argument(s), got %d at %s:%d:%d",
+			target.Name, len(target.SigilParams), len(args),
+			tokens[start].File, tokens[start].Line, tokens[start].Column,
+		)
+	}
+
 	// Build child environment:
 	// - inherit only VISIBLE sigils by default
-	// - set the first param to argVal if present
+	// - bind each param to its positional argument
 	childSigils := make(sigilTable)
 	cloneVisibleSigils(childSigils, sigils)
 
-	// If the callee expects a first parameter, bind it.
-	if len(target.SigilParams) > 0 {
-		param := target.SigilParams[0]
-		childSigils[param] = argVal
+	tainted := false
+	for n, arg := range args {
+		param := target.SigilParams[n]
+		childSigils[param] = arg.val
 
 		// If caller explicitly referenced an invisible sigil as the arg,
 		// that is an intentional copy into the callee param; keep it invisible.
-		if argFromSigil != "" && argWasInvisible {
+		if arg.invisible {
 			markInvisibleSigil(childSigils, param)
+			tainted = true
 		}
 	}
 
@@ -4224,96 +7645,15 @@ func evalSummonExpr(prog *Program, tokens []Token, start int, sigils sigilTable)
 		// Missing or wrong seal => raise OMEN and do not execute target.
 		if got == "" || got != want {
 			consumed := i - start
-			return "", consumed, &omenError{name: "sealed_work"}
+			return "", false, consumed, &omenError{name: "sealed_work"}
 		}
 	}
 
 	result, err := execWork(prog, target, childSigils, true)
 	if err != nil {
-		return "", 0, err
+		return "", false, 0, err
 	}
 
 	consumed := i - start
-	return result, consumed, nil
-}
-
-func evalExpr(prog *Program, tokens []Token, i int, sigils sigilTable) (string, int, error) {
-	start := i
-
-	// ---- Parse left operand ----
-	leftTok := tokens[i]
-	var leftVal string
-
-	switch leftTok.Type {
-	case TOK_IDENT:
-		v, ok := sigils[leftTok.Lexeme]
-		if !ok {
-			return "", 0, fmt.Errorf("unknown SIGIL %s at %s:%d:%d",
-				leftTok.Lexeme, leftTok.File, leftTok.Line, leftTok.Column)
-		}
-		leftVal = v
-		i++
-
-	case TOK_STRING:
-		leftVal = leftTok.Lexeme
-		i++
-
-	case TOK_NUM:
-		leftVal = leftTok.Lexeme
-		i++
-
-	default:
-		return "", 0, fmt.Errorf("unexpected %s in expr at %s:%d:%d",
-			leftTok.Type, leftTok.File, leftTok.Line, leftTok.Column)
-	}
-
-	// ---- Check if there's an operator ----
-	if i >= len(tokens) || tokens[i].Type != TOK_PLUS {
-		// Single value expression
-		return leftVal, i - start, nil
-	}
-
-	// We saw a +
-	i++ // consume +
-
-	// ---- Parse right operand ----
-	if i >= len(tokens) {
-		return "", 0, fmt.Errorf("expected right operand after +")
-	}
-
-	rightTok := tokens[i]
-	var rightVal string
-
-	switch rightTok.Type {
-	case TOK_IDENT:
-		v, ok := sigils[rightTok.Lexeme]
-		if !ok {
-			return "", 0, fmt.Errorf("unknown SIGIL %s", rightTok.Lexeme)
-		}
-		rightVal = v
-		i++
-
-	case TOK_STRING:
-		rightVal = rightTok.Lexeme
-		i++
-
-	case TOK_NUM:
-		rightVal = rightTok.Lexeme
-		i++
-
-	default:
-		return "", 0, fmt.Errorf("unexpected %s after +", rightTok.Type)
-	}
-
-	// ---- Evaluate the expression ----
-
-	// Try numeric addition first
-	if lv, err1 := strconv.Atoi(leftVal); err1 == nil {
-		if rv, err2 := strconv.Atoi(rightVal); err2 == nil {
-			return fmt.Sprintf("%d", lv+rv), i - start, nil
-		}
-	}
-
-	// Otherwise: string concatenation
-	return leftVal + rightVal, i - start, nil
+	return result, tainted, consumed, nil
 }
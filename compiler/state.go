@@ -0,0 +1,147 @@
+package compiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ---- DUMP STATE / LOAD STATE: sigil snapshots for debugging ----
+//
+//	DUMP STATE TO "state.json".
+//	LOAD STATE FROM "state.json".
+//
+// DUMP writes the visible sigils of the current WORK as a JSON object,
+// sorted by name, each with its exact text and the type it reads as:
+//
+//	{"count": {"type": "number", "value": "3"}, "name": {"type": "text", "value": "ada"}}
+//
+// LOAD binds every sigil in such a file, so a dump round-trips exactly.
+// INVISIBLE sigils and runtime meta keys are never dumped, and LOAD will
+// not overwrite an INVISIBLE sigil. Paths resolve against the scroll's
+// directory, as for RENDER.
+//
+// Failures raise catchable OMENs:
+//   - "state_denied": PROFILE "SANDBOX", or a path outside the scroll dir
+//   - "state_failed": the file cannot be written, read or decoded
+
+const (
+	sicOmenStateDenied = "state_denied"
+	sicOmenStateFailed = "state_failed"
+)
+
+// stateEntry is one sigil in a DUMP STATE file.
+type stateEntry struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// stateType names the type a sigil value reads as: bool, number or text.
+func stateType(v string) string {
+	s := strings.TrimSpace(v)
+	if strings.EqualFold(s, "true") || strings.EqualFold(s, "false") {
+		return "bool"
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return "number"
+	}
+	return "text"
+}
+
+// execState executes DUMP STATE TO <path>. and LOAD STATE FROM <path>.
+func execState(prog *Program, tokens []Token, i int, sigils sigilTable) (int, error) {
+	startTok := tokens[i] // IDENT "DUMP" or "LOAD"
+	dump := isWord(startTok, "DUMP")
+	verb := strings.ToUpper(startTok.Lexeme)
+	i++
+
+	if i >= len(tokens) || !isWord(tokens[i], "STATE") {
+		return i, fmt.Errorf("%s: expected STATE after %s at %s:%d:%d",
+			verb, verb, startTok.File, startTok.Line, startTok.Column)
+	}
+	i++
+	if dump && (i >= len(tokens) || !isWord(tokens[i], "TO")) {
+		return i, fmt.Errorf("DUMP: expected TO after DUMP STATE at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	if !dump && (i >= len(tokens) || tokens[i].Type != TOK_FROM) {
+		return i, fmt.Errorf("LOAD: expected FROM after LOAD STATE at %s:%d:%d",
+			startTok.File, startTok.Line, startTok.Column)
+	}
+	i++
+
+	pathStart := i
+	for i < len(tokens) && tokens[i].Type != TOK_DOT && tokens[i].Type != TOK_NEWLINE {
+		i++
+	}
+	if pathStart == i {
+		return i, fmt.Errorf("%s: expected file path at %s:%d:%d",
+			verb, startTok.File, startTok.Line, startTok.Column)
+	}
+	path, err := evalStringExpr(prog, tokens[pathStart:i], sigils)
+	if err != nil {
+		return i, err
+	}
+	i = consumeTerminator(tokens, i)
+
+	fail := func(omen, format string, args ...interface{}) (int, error) {
+		fmt.Fprintf(os.Stderr, "[SIC STATE] %s at %s:%d:%d\n",
+			fmt.Sprintf(format, args...), startTok.File, startTok.Line, startTok.Column)
+		return i, &omenError{name: omen}
+	}
+
+	if isSandboxed(prog) {
+		return fail(sicOmenStateDenied, "file access is not allowed under PROFILE %q", prog.Profile)
+	}
+	full, ok := resolveScrollPath(startTok, path)
+	if !ok {
+		return fail(sicOmenStateDenied, "state path %q must stay inside the scroll directory", path)
+	}
+
+	if dump {
+		state := make(map[string]stateEntry)
+		for k, v := range sigils {
+			if strings.HasPrefix(k, "__") || isInvisibleSigil(sigils, k) {
+				continue
+			}
+			state[k] = stateEntry{Type: stateType(v), Value: v}
+		}
+		data, err := json.MarshalIndent(state, "", "  ")
+		if err != nil {
+			return fail(sicOmenStateFailed, "cannot encode state: %v", err)
+		}
+		if err := os.WriteFile(full, append(data, '\n'), 0o644); err != nil {
+			return fail(sicOmenStateFailed, "cannot write state: %v", err)
+		}
+		return i, nil
+	}
+
+	data, err := os.ReadFile(full)
+	if err != nil {
+		return fail(sicOmenStateFailed, "cannot read state: %v", err)
+	}
+	var state map[string]stateEntry
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fail(sicOmenStateFailed, "cannot decode state %s: %v", path, err)
+	}
+	names := make([]string, 0, len(state))
+	for k := range state {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if k == "" || strings.HasPrefix(k, "__") {
+			return fail(sicOmenStateFailed, "state %s holds reserved sigil name %q", path, k)
+		}
+		if isInvisibleSigil(sigils, k) {
+			return fail(sicOmenStateFailed, "state %s would overwrite INVISIBLE sigil %s", path, k)
+		}
+	}
+	for _, k := range names {
+		setSigil(sigils, k, clampSigilValue(state[k].Value))
+	}
+	return i, nil
+}
@@ -7,6 +7,7 @@ const (
 	TOK_ILLEGAL TokenType = "ILLEGAL"
 	TOK_EOF     TokenType = "EOF"
 	TOK_NEWLINE TokenType = "NEWLINE"
+	TOK_COMMENT TokenType = "COMMENT" // only emitted when Lexer.SetEmitComments(true)
 	TOK_DOT     TokenType = "."
 	TOK_SEAL    TokenType = "SEAL"
 	TOK_SEALED  TokenType = "SEALED"
@@ -111,7 +112,7 @@ const (
 	TOK_MINUS   TokenType = "MINUS"   // -
 	TOK_STAR    TokenType = "STAR"    // *
 	TOK_PERCENT TokenType = "PERCENT" // %
-	TOK_BANG    TokenType = "BANG"    // !
+	TOK_BANG    TokenType = "BANG"    // ! (unused: the lexer emits TOK_NOT)
 	TOK_LT      TokenType = "LT"      // <
 	TOK_GT      TokenType = "GT"      // >
 	TOK_LTE     TokenType = "LTE"     // <=
@@ -119,9 +120,13 @@ const (
 	TOK_EQ      TokenType = "EQ"      // ==
 	TOK_NEQ     TokenType = "NEQ"     // !=
 
+	TOK_STRICT_EQ  TokenType = "STRICT_EQ"  // === (same kind and value)
+	TOK_STRICT_NEQ TokenType = "STRICT_NEQ" // !==
+	TOK_FOLD_EQ    TokenType = "FOLD_EQ"    // ~= (text equal ignoring case)
+
 	TOK_AND TokenType = "AND" // AND
 	TOK_OR  TokenType = "OR"  // OR
-	TOK_NOT TokenType = "NOT" // NOT
+	TOK_NOT TokenType = "NOT" // NOT or !
 
 	TOK_LOG TokenType = "LOG" // LOG keyword or symbol
 
@@ -136,12 +141,19 @@ const (
 )
 
 // Token is the unified lexical unit used by lexer and parser.
+//
+// Offset and End are byte indexes into the lexed source: src[Offset:End]
+// is the token's exact source text, delimiters included (the quotes of a
+// string, the backticks of an escaped name), even where Lexeme is
+// normalized. Tokens not built by the Lexer leave both at 0.
 type Token struct {
 	Type   TokenType
 	Lexeme string
 	Line   int
 	Column int
 	File   string
+	Offset int
+	End    int
 }
 
 func NewToken(t TokenType, lex string, file string, line int, col int) Token {
@@ -0,0 +1,49 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+D="$ROOT/tests/tls"
+
+fail=0
+trap 'rm -f "$D/cert.pem" "$D/key.pem"' EXIT
+
+openssl req -x509 -newkey rsa:2048 -nodes -days 1 -subj "/CN=localhost" \
+  -keyout "$D/key.pem" -out "$D/cert.pem" >/dev/null 2>&1 || {
+  echo "[FAIL] openssl could not create a self-signed certificate"
+  exit 1
+}
+
+"$SIC" run "$D/test_altar_tls.sic" >/dev/null 2>&1 &
+pid=$!
+sleep 0.5
+
+got="$(curl -sk "https://localhost:15104/secure")"
+if [ "$got" = "over TLS" ]; then
+  echo "[OK] HTTPS request -> $got"
+else
+  echo "[FAIL] HTTPS request -> '$got' (want 'over TLS')"
+  fail=1
+fi
+
+code="$(curl -s -o /dev/null -w '%{http_code}' "http://localhost:15104/secure")"
+if [ "$code" = "400" ]; then
+  echo "[OK] plain HTTP on the TLS port -> 400"
+else
+  echo "[FAIL] plain HTTP on the TLS port -> $code (want 400)"
+  fail=1
+fi
+wait "$pid"
+
+# A missing KEY file fails at startup, before anything listens.
+rm -f "$D/key.pem"
+"$SIC" run "$D/test_altar_tls.sic" >/dev/null 2>&1
+got=$?
+if [ "$got" -eq 3 ]; then
+  echo "[OK] missing KEY file -> 3"
+else
+  echo "[FAIL] missing KEY file -> $got (want 3)"
+  fail=1
+fi
+
+exit "$fail"
@@ -0,0 +1,39 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+SOCK="/tmp/sic_altar_test.sock"
+
+fail=0
+
+"$SIC" run "$ROOT/tests/test_altar_unix_socket.sic" >/dev/null 2>&1 &
+pid=$!
+for _ in $(seq 20); do
+  [ -S "$SOCK" ] && break
+  sleep 0.1
+done
+
+check() {
+  local path="$1" want="$2" got
+  got="$(curl -s --unix-socket "$SOCK" "http://sic$path")"
+  if [ "$got" = "$want" ]; then
+    echo "[OK] GET $path over $SOCK -> $got"
+  else
+    echo "[FAIL] GET $path over $SOCK -> '$got' (want '$want')"
+    fail=1
+  fi
+}
+check /ping "pong"
+check /hello "hello over a socket"
+
+wait "$pid"
+if [ -e "$SOCK" ]; then
+  echo "[FAIL] $SOCK left behind after the scroll finished"
+  rm -f "$SOCK"
+  fail=1
+else
+  echo "[OK] socket file removed on shutdown"
+fi
+
+exit "$fail"
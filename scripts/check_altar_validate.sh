@@ -0,0 +1,41 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+BASE="http://localhost:15101"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+"$SIC" run "$ROOT/tests/test_altar_validate.sic" >/dev/null 2>&1 &
+pid=$!
+for _ in $(seq 20); do
+  curl -s -o /dev/null "$BASE/age" && break
+  sleep 0.1
+done
+
+check() {
+  local path="$1" want_status="$2" want_body="$3" status body
+  status="$(curl -s -o "$TMP/body" -w '%{http_code}' "$BASE$path")"
+  body="$(cat "$TMP/body")"
+  if [ "$status" = "$want_status" ] && [ "$body" = "$want_body" ]; then
+    echo "[OK] GET $path -> $status $body"
+  else
+    echo "[FAIL] GET $path -> $status '$body' (want $want_status '$want_body')"
+    fail=1
+  fi
+}
+check "/age?age=42&name=ada" 200 "ada is 42"
+check "/age?age=4.5&name=ada" 200 "ada is 4.5"
+check "/age?age=old&name=ada" 400 "bad age"
+check "/age?age=NaN&name=ada" 400 "bad age"
+check "/age?age=Inf&name=ada" 400 "bad age"
+check "/age?age=-Infinity&name=ada" 400 "bad age"
+check "/age?age=0x1p3&name=ada" 400 "bad age"
+check "/age?age=42" 400 "name is required"
+check "/flag?on=yes" 422 "Unprocessable Entity"
+
+wait "$pid"
+exit "$fail"
@@ -0,0 +1,27 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="$ROOT/tests/test_work_doc.sic"
+
+# DOC clause, comment-block doc, and an undocumented WORK.
+want='== SIC ANALYZE ==
+Scroll: test_work_doc
+Works:
+  - GREET(name)
+      Greets name politely.
+  - SHOUT(words)
+      Upper-cases words.
+      Used for headings.
+  - MAIN(UNUSED)
+      (no doc)'
+
+got="$("$SIC" analyze "$F")"
+if [ "$got" = "$want" ]; then
+  echo "[OK] sic analyze prints WORK docs"
+  exit 0
+fi
+echo "[FAIL] sic analyze output differs:"
+diff <(echo "$want") <(echo "$got")
+exit 1
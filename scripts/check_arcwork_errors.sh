@@ -0,0 +1,34 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+T="$ROOT/tests"
+
+fail=0
+check() {
+  if echo "$2" | grep -qF -- "$3"; then
+    echo "[OK] $1"
+  else
+    echo "[FAIL] $1 (want: $3)"
+    echo "$2"
+    fail=1
+  fi
+}
+
+# ARCWORK errors name the offending line, not just the sigil.
+F="$T/test_arcwork_lower_float_negative.sic"
+out="$("$SIC" run "$F" 2>&1)"
+check "float target points at LOWER" "$out" \
+  "ARCWORK LOWER: SIGIL health does not hold integer \"7.5\" (LOWER needs whole numbers; RAISE takes decimals) at $F:14:9"
+
+F="$T/test_arcwork_lower_operand_negative.sic"
+out="$("$SIC" run "$F" 2>&1)"
+check "float operand points at the operand" "$out" \
+  "ARCWORK: operand SIGIL step does not hold integer \"0.5\" at $F:13:33"
+
+# Whole numbers still work.
+out="$("$SIC" run "$ROOT/examples/arcwork_demo.sic" 2>&1)"
+check "integer LOWER unchanged" "$out" "Counter is 2."
+
+exit "$fail"
@@ -0,0 +1,44 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="$ROOT/tests/test_block_comments.sic"
+NEG="$ROOT/tests/test_block_comment_unterminated_negative.sic"
+
+fail=0
+check() {
+  if echo "$2" | grep -qF "$3"; then
+    echo "[OK] $1"
+  else
+    echo "[FAIL] $1 (want: $3)"
+    echo "$2"
+    fail=1
+  fi
+}
+reject() {
+  if echo "$2" | grep -qF "$3"; then
+    echo "[FAIL] $1 (unexpected: $3)"
+    fail=1
+  else
+    echo "[OK] $1"
+  fi
+}
+
+out="$("$SIC" run "$F" 2>&1)"
+check "single-line block comments skipped" "$out" "[SIC SAY] inline 5"
+reject "commented-out SAY not run" "$out" "skipped"
+reject "multi-line block comment skipped" "$out" "this is not run"
+
+# Newlines inside a comment still advance the line count.
+out="$("$SIC" lex "$F")"
+check "line tracking after multi-line comment" "$out" "WORK         \"WORK\"               ($F:14:1)"
+check "code after a comment on the same line" "$out" "SAY          \"SAY\"                ($F:17:25)"
+
+# An unterminated comment is reported where it opens.
+out="$("$SIC" lex "$NEG")"
+check "unterminated -> ILLEGAL at opening" "$out" "ILLEGAL      \"/*\"                 ($NEG:8:3)"
+out="$("$SIC" run "$NEG" 2>&1)"
+check "unterminated is a parse error" "$out" "$NEG:8:3: unterminated block comment"
+
+exit "$fail"
@@ -0,0 +1,50 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+T="$ROOT/tests"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+# The cache switch is internal, so both paths run through the harness.
+if ! (cd "$ROOT" && go build -o "$TMP/harness" ./scripts/harness) 2>"$TMP/build.txt"; then
+  echo "[FAIL] harness build: $(head -1 "$TMP/build.txt")"
+  exit 1
+fi
+H="$TMP/harness"
+
+# Cached and uncached WORK bodies must run every scroll identically.
+for f in test_summon_repeat.sic test_block_scope.sic test_do_block.sic \
+         test_omen_wildcard.sic test_with_timeout.sic bench/summon_many.sic; do
+  cached="$("$H" run "$T/$f" 2>&1)"
+  uncached="$("$H" run --no-body-cache "$T/$f" 2>&1)"
+  if [ "$cached" = "$uncached" ]; then
+    echo "[OK] $f: cached and uncached bodies match"
+  else
+    echo "[FAIL] $f: cached and uncached output differ:"
+    diff <(echo "$uncached") <(echo "$cached")
+    fail=1
+  fi
+done
+
+# Benchmark: many SUMMONs of one WORK, best of 3 runs each way.
+best() {
+  local min=""
+  for _ in 1 2 3; do
+    local start end ms
+    start="$(date +%s%N)"
+    "$H" run "$@" >/dev/null 2>&1
+    end="$(date +%s%N)"
+    ms=$(( (end - start) / 1000000 ))
+    if [ -z "$min" ] || [ "$ms" -lt "$min" ]; then
+      min="$ms"
+    fi
+  done
+  echo "$min"
+}
+echo "[BENCH] 20000 SUMMONs cached:   $(best "$T/bench/summon_many.sic") ms"
+echo "[BENCH] 20000 SUMMONs uncached: $(best --no-body-cache "$T/bench/summon_many.sic") ms"
+
+exit "$fail"
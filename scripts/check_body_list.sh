@@ -0,0 +1,36 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+BASE="http://localhost:15105"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+"$SIC" run "$ROOT/tests/test_altar_body_list.sic" >/dev/null 2>&1 &
+pid=$!
+for _ in $(seq 20); do
+  curl -s -o /dev/null "$BASE/sum" && break
+  sleep 0.1
+done
+
+check() {
+  local path="$1" body="$2" want="$3" status got
+  status="$(curl -s -o "$TMP/out" -w '%{http_code}' -d "$body" "$BASE$path")"
+  got="$(cat "$TMP/out")"
+  if [ "$status" = "200" ] && [ "$got" = "$want" ]; then
+    echo "[OK] POST $path $body -> $got"
+  else
+    echo "[FAIL] POST $path $body -> $status '$got' (want 200 '$want')"
+    fail=1
+  fi
+}
+check /sum '[3,4,5]' "3 items, sum 12"
+check /sum '[]' "0 items, sum 0"
+check /sum '{"a":1}' "0 items, sum 0"
+check /show '["ada", {"id": 7}, null]' 'ada | {"id":7} | '
+
+wait "$pid"
+exit "$fail"
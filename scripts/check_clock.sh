@@ -0,0 +1,54 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+D="$ROOT/tests/clock"
+
+fail=0
+
+# 90 seconds of SLEEP on the fake clock must not take real time.
+want='[SIC SAY] start 1700000000
+[SIC SAY] after 30s 1700000030
+[SIC SAY] after 1 minute 1700000090
+[SIC SAY] elapsed 90'
+SECONDS=0
+got="$("$SIC" run --fake-clock 1700000000 "$D/fake_clock.sic" 2>&1)"
+took=$SECONDS
+if [ "$got" = "$want" ]; then
+  echo "[OK] --fake-clock drives SLEEP, TIME_NOW and ELAPSED"
+else
+  echo "[FAIL] --fake-clock output differs:"
+  diff <(echo "$want") <(echo "$got")
+  fail=1
+fi
+if [ "$took" -lt 5 ]; then
+  echo "[OK] fake SLEEP did not wait (${took}s)"
+else
+  echo "[FAIL] fake SLEEP took ${took}s"
+  fail=1
+fi
+
+# STOPWATCH readings are exact on the fake clock.
+want='[SIC SAY] first: 0
+[SIC SAY] second: 1
+[SIC SAY] monotonic: true'
+got="$("$SIC" run --fake-clock 1700000000 "$ROOT/tests/test_stopwatch.sic" 2>&1)"
+if [ "$got" = "$want" ]; then
+  echo "[OK] STOPWATCH/ELAPSED on the fake clock"
+else
+  echo "[FAIL] test_stopwatch output differs:"
+  diff <(echo "$want") <(echo "$got")
+  fail=1
+fi
+
+"$SIC" run --fake-clock soon "$D/fake_clock.sic" >/dev/null 2>&1
+got=$?
+if [ "$got" -eq 1 ]; then
+  echo "[OK] bad --fake-clock -> 1"
+else
+  echo "[FAIL] bad --fake-clock -> $got (want 1)"
+  fail=1
+fi
+
+exit "$fail"
@@ -0,0 +1,47 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+D="$ROOT/tests/config"
+
+fail=0
+
+want='[SIC SAY] Hello from config
+[SIC SAY] 4
+[SIC SAY] true
+[SIC SAY] localhost:5432'
+got="$("$SIC" run --config "$D/test_config.json" "$D/config_sigils.sic" 2>&1)"
+if [ "$got" = "$want" ]; then
+  echo "[OK] --config seeds sigils, nested keys read with CONFIG"
+else
+  echo "[FAIL] --config output differs:"
+  diff <(echo "$want") <(echo "$got")
+  fail=1
+fi
+
+# Without the config, CONFIG falls back to its defaults.
+want='[SIC SAY] no config
+[SIC SAY] 2
+[SIC SAY] false
+[SIC SAY] 127.0.0.1:80'
+got="$("$SIC" run "$D/config_sigils.sic" 2>&1)"
+if [ "$got" = "$want" ]; then
+  echo "[OK] no --config -> defaults"
+else
+  echo "[FAIL] no --config output differs:"
+  diff <(echo "$want") <(echo "$got")
+  fail=1
+fi
+
+# A config that is not a JSON object is a usage error.
+"$SIC" run --config "$D/config_sigils.sic" "$D/config_sigils.sic" >/dev/null 2>&1
+got=$?
+if [ "$got" -eq 1 ]; then
+  echo "[OK] bad --config -> 1"
+else
+  echo "[FAIL] bad --config -> $got (want 1)"
+  fail=1
+fi
+
+exit "$fail"
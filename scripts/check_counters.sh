@@ -0,0 +1,44 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+T="$ROOT/tests"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+want='[SIC SAY] before: 0 {}
+[SIC SAY] cache_miss: 5
+[SIC SAY] cache_hit: 2
+[SIC SAY] all: {"cache_hit":2,"cache_miss":5}
+[SIC SAY] never fired: 0'
+got="$("$SIC" run "$T/test_event_counters.sic" 2>/dev/null)"
+if [ "$got" = "$want" ]; then
+  echo "[OK] COUNT EVENT / COUNTERS from a loop"
+else
+  echo "[FAIL] counter output differs:"
+  diff <(echo "$want") <(echo "$got")
+  fail=1
+fi
+
+# CHOIR tasks share the registry: build with the race detector and make
+# sure concurrent increments are neither lost nor racy.
+if (cd "$ROOT" && go build -race -o "$TMP/sic-race" ./cli) 2>"$TMP/build.txt"; then
+  out="$("$TMP/sic-race" run "$T/test_event_counters_choir.sic" 2>&1)"
+  if grep -q "DATA RACE" <<<"$out"; then
+    echo "[FAIL] race detector fired:"
+    echo "$out" | head -30
+    fail=1
+  elif [ "$(tail -1 <<<"$out")" = "[SIC SAY] hits: 2000" ]; then
+    echo "[OK] CHOIR increments under -race"
+  else
+    echo "[FAIL] CHOIR counter: $out"
+    fail=1
+  fi
+else
+  echo "[SKIP] -race build unavailable: $(head -1 "$TMP/build.txt")"
+fi
+
+exit "$fail"
@@ -0,0 +1,32 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+D="tests/diag"
+
+fail=0
+check() {
+  local name="$1" want_rc="$2" want="$3" got rc
+  got="$(cd "$ROOT" && "$SIC" run "$D/$name" 2>&1 >/dev/null)"
+  rc=$?
+  got="$(grep '^parse ' <<<"$got")"
+  if [ "$rc" -eq "$want_rc" ] && [ "$got" = "$want" ]; then
+    echo "[OK] $name diagnostics (exit $rc)"
+  else
+    echo "[FAIL] $name: exit $rc (want $want_rc)"
+    diff <(echo "$want") <(echo "$got")
+    fail=1
+  fi
+}
+
+# Severity, file, line and column of every diagnostic, in reported order.
+check warnings.sic 0 "parse warning: $D/warnings.sic:2:8: unknown SCROLL strength MEDIUM (expected STRONG or WEAK)
+parse warning: $D/warnings.sic:9:1: unexpected STRING at top level"
+
+check errors.sic 2 "parse warning: $D/errors.sic:12:1: unexpected STRING at top level
+parse error: $D/errors.sic:14:1: WORK HELPER is missing ENDWORK (WORK at 17:1 starts before it ends)
+parse error: $D/errors.sic:19:3: unterminated block comment
+parse error: $D/errors.sic:17:1: WORK MAIN is missing ENDWORK (reached end of file)"
+
+exit "$fail"
@@ -0,0 +1,42 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+T="$ROOT/tests"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+out="$("$SIC" run "$T/test_entangle_modes.sic" 2>&1)"
+if grep -qF "SHARED published, COPY discarded" <<<"$out"; then
+  echo "[OK] SHARED and COPY modes"
+else
+  echo "[FAIL] ENTANGLE modes: $out"
+  fail=1
+fi
+
+# Each CHAMBER keeps its own entanglement frame: concurrent CHOIR tasks
+# must publish only into their own WORK, without racing.
+want='[SIC SAY] tally: 200
+[SIC SAY] tally: 200
+[SIC SAY] tally: 200
+[SIC SAY] tally: 200'
+if (cd "$ROOT" && go build -race -o "$TMP/sic-race" ./cli) 2>"$TMP/build.txt"; then
+  out="$("$TMP/sic-race" run "$T/test_entangle_choir.sic" 2>&1)"
+  if grep -q "DATA RACE" <<<"$out"; then
+    echo "[FAIL] race detector fired:"
+    echo "$out" | head -30
+    fail=1
+  elif [ "$out" = "$want" ]; then
+    echo "[OK] CHAMBERs in CHOIR tasks under -race"
+  else
+    echo "[FAIL] CHOIR CHAMBERs: $out"
+    fail=1
+  fi
+else
+  echo "[SKIP] -race build unavailable: $(head -1 "$TMP/build.txt")"
+fi
+
+exit "$fail"
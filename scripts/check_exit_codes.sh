@@ -0,0 +1,84 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+T="$ROOT/tests"
+
+# expected exit code | command...
+cases=(
+  "0|run $T/test_say.sic"
+  "1|run $T/does_not_exist.sic"
+  "2|run $T/test_exit_parse_negative.sic"
+  "2|parse $T/test_exit_parse_negative.sic"
+  "2|parse $T/test_missing_endwork_negative.sic"
+  "2|run $T/test_block_comment_unterminated_negative.sic"
+  "3|run $T/test_exit_runtime_negative.sic"
+  "0|run $T/test_halt.sic"
+  "7|run $T/test_halt_code_negative.sic"
+  "3|run $T/test_altar_path_slash_negative.sic"
+  "3|run $T/test_altar_path_empty_negative.sic"
+  "3|run $T/test_altar_on_start_negative.sic"
+  "3|run $T/test_work_requires_negative.sic"
+  "3|run $T/test_work_requires_before_with_negative.sic"
+  "3|run $T/test_summon_arity_negative.sic"
+  "3|run $T/test_summon_arity_too_few_negative.sic"
+  "3|run $T/test_map_element_negative.sic"
+  "3|run $T/test_say_precision_negative.sic"
+  "3|run $T/test_do_unmatched_negative.sic"
+  "3|run $T/test_arcwork_lower_float_negative.sic"
+  "3|run $T/test_arcwork_lower_operand_negative.sic"
+  "3|run $T/test_with_timeout_unmatched_negative.sic"
+  "2|run $T/test_triple_string_unterminated_negative.sic"
+)
+
+fail=0
+for c in "${cases[@]}"; do
+  want="${c%%|*}"
+  cmd="${c#*|}"
+  # shellcheck disable=SC2086
+  "$SIC" $cmd >/dev/null 2>&1
+  got=$?
+  if [ "$got" -eq "$want" ]; then
+    echo "[OK] sic $cmd -> $got"
+  else
+    echo "[FAIL] sic $cmd -> $got (want $want)"
+    fail=1
+  fi
+done
+
+# `sic run -` reads the scroll from stdin; positions report <stdin>.
+# expected exit code | scroll fed on stdin
+stdin_cases=(
+  "0|$T/test_say.sic"
+  "2|$T/test_exit_parse_negative.sic"
+  "3|$T/test_exit_runtime_negative.sic"
+)
+for c in "${stdin_cases[@]}"; do
+  want="${c%%|*}"
+  src="${c#*|}"
+  out="$("$SIC" run - <"$src" 2>&1)"
+  got=$?
+  if [ "$got" -ne "$want" ]; then
+    echo "[FAIL] sic run - <$src -> $got (want $want)"
+    fail=1
+  elif [ "$want" -eq 3 ] && ! echo "$out" | grep -q "<stdin>:"; then
+    echo "[FAIL] sic run - <$src: error does not point at <stdin>"
+    fail=1
+  else
+    echo "[OK] sic run - <$src -> $got"
+  fi
+done
+
+# A WORK missing ENDWORK is reported at its own header, and the parser
+# stops at the next WORK instead of absorbing it.
+out="$("$SIC" parse "$T/test_missing_endwork_negative.sic" 2>&1)"
+if echo "$out" | grep -q ":10:1: error: WORK FIRST is missing ENDWORK (WORK at 13:1"; then
+  echo "[OK] missing ENDWORK reported, next WORK kept"
+else
+  echo "[FAIL] missing ENDWORK: unexpected output:"
+  echo "$out"
+  fail=1
+fi
+
+exit "$fail"
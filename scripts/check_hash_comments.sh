@@ -0,0 +1,28 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="$ROOT/tests/test_hash_comments.sic"
+
+fail=0
+check() {
+  if echo "$2" | grep -qF "$3"; then
+    echo "[OK] $1"
+  else
+    echo "[FAIL] $1 (want: $3)"
+    echo "$2"
+    fail=1
+  fi
+}
+
+# With # comments on (the default), the lexer skips them and keeps line
+# numbers: the statement after a mid-line comment is still on line 14.
+out="$("$SIC" lex "$F")"
+check "start-of-line and mid-line # skipped" "$out" "SAY          \"SAY\"                ($F:14:3)"
+
+# Turned off, # is no longer a comment.
+out="$("$SIC" lex --no-hash-comments "$F")"
+check "--no-hash-comments -> ILLEGAL" "$out" "ILLEGAL      \"#\"                  ($F:5:1)"
+
+exit "$fail"
@@ -0,0 +1,40 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+D="$ROOT/tests/include"
+
+fail=0
+
+want='[SIC SAY] hello, ada
+[SIC SAY] HELLO, ADA!
+[SIC SAY] back in the main scroll'
+got="$("$SIC" run "$D/test_include.sic" 2>/dev/null)"
+if [ "$got" = "$want" ]; then
+  echo "[OK] nested INCLUDE resolves relative to the including file"
+else
+  echo "[FAIL] include output differs:"
+  diff <(echo "$want") <(echo "$got")
+  fail=1
+fi
+
+# Running from another directory must not change how paths resolve.
+got="$(cd / && "$SIC" run "$D/test_include.sic" 2>/dev/null)"
+if [ "$got" = "$want" ]; then
+  echo "[OK] INCLUDE ignores the working directory"
+else
+  echo "[FAIL] INCLUDE depends on the working directory"
+  fail=1
+fi
+
+out="$("$SIC" run "$D/test_include_cycle_negative.sic" 2>&1)"
+rc=$?
+if [ "$rc" -eq 2 ] && grep -q 'INCLUDE cycle: cycle_a.sic -> cycle_b.sic -> cycle_a.sic' <<<"$out"; then
+  echo "[OK] include cycle is a parse error"
+else
+  echo "[FAIL] include cycle -> exit $rc: $out"
+  fail=1
+fi
+
+exit "$fail"
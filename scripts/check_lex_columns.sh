@@ -0,0 +1,48 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+# Columns are 1-based and count runes: the first character of every line
+# is column 1, and a NEWLINE sits at the end of the line it terminates.
+printf 'name BE 1.\n  "é" x\n' > "$TMP/c.sic"
+out="$("$SIC" lex "$TMP/c.sic")"
+
+# n-th token | expected line:col
+cases=(
+  "1|1:1"  # name, first identifier in the file
+  "2|1:6"  # BE
+  "5|1:11" # NEWLINE ending line 1
+  "6|2:3"  # "é", after two spaces
+  "7|2:7"  # x, after a two-byte rune
+  "8|2:8"  # NEWLINE ending line 2
+  "9|3:1"  # EOF, start of the empty last line
+)
+for c in "${cases[@]}"; do
+  n="${c%%|*}"
+  want="${c#*|}"
+  line="$(sed -n "${n}p" <<<"$out")"
+  got="$(sed -E 's/.*:([0-9]+:[0-9]+)\)$/\1/' <<<"$line")"
+  if [ "$got" = "$want" ]; then
+    echo "[OK] token $n -> $got"
+  else
+    echo "[FAIL] token $n -> $got (want $want): $line"
+    fail=1
+  fi
+done
+
+printf 'name' > "$TMP/one.sic"
+out="$("$SIC" lex "$TMP/one.sic")"
+if [ "$(head -1 <<<"$out")" = "IDENT        \"name\"               ($TMP/one.sic:1:1)" ]; then
+  echo "[OK] lone identifier is at 1:1"
+else
+  echo "[FAIL] lone identifier: $(head -1 <<<"$out")"
+  fail=1
+fi
+
+exit "$fail"
@@ -0,0 +1,33 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="tests/test_lex_comments.sic"
+
+fail=0
+
+# --comments emits every comment with its text, line and column.
+want="COMMENT      \"// Leading file comment.\" ($F:1:1)
+COMMENT      \"// Attached to MAIN.\" ($F:6:1)
+COMMENT      \"// trailing comment\" ($F:8:43)"
+got="$(cd "$ROOT" && "$SIC" lex --comments "$F" 2>&1)"
+got="$(grep '^COMMENT' <<<"$got")"
+if [ "$got" = "$want" ]; then
+  echo "[OK] lex --comments positions"
+else
+  echo "[FAIL] lex --comments output differs:"
+  diff <(echo "$want") <(echo "$got")
+  fail=1
+fi
+
+# Without the flag comments are skipped.
+got="$(cd "$ROOT" && "$SIC" lex "$F" 2>&1)"
+if grep -q '^COMMENT' <<<"$got"; then
+  echo "[FAIL] lex without --comments emitted comments"
+  fail=1
+else
+  echo "[OK] lex skips comments by default"
+fi
+
+exit "$fail"
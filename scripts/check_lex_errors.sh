@@ -0,0 +1,43 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="$ROOT/tests/lex/two_illegal.sic"
+
+fail=0
+check() {
+  if echo "$2" | grep -qF -- "$3"; then
+    echo "[OK] $1"
+  else
+    echo "[FAIL] $1 (want: $3)"
+    echo "$2"
+    fail=1
+  fi
+}
+
+out="$("$SIC" lex "$F")"
+rc=$?
+
+# Lexing continues past the first ILLEGAL token...
+check "first illegal character reported" "$out" "  - $F:2:18: illegal character '@'"
+check "second illegal character reported" "$out" "  - $F:5:8: illegal character '^'"
+check "error count" "$out" "2 lexer error(s):"
+check "tokens after an error still lexed" "$out" "STRING       \"fine\""
+
+# ...and a file with lexer errors is a failed lex.
+if [ "$rc" -eq 2 ]; then
+  echo "[OK] sic lex exits 2 on lexer errors"
+else
+  echo "[FAIL] sic lex exited $rc (want 2)"
+  fail=1
+fi
+
+if "$SIC" lex "$ROOT/tests/test_say.sic" >/dev/null; then
+  echo "[OK] clean file lexes with exit 0"
+else
+  echo "[FAIL] clean file did not lex cleanly"
+  fail=1
+fi
+
+exit "$fail"
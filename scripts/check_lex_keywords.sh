@@ -0,0 +1,49 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+# keyword | expected token type. Every statement keyword execWork dispatches
+# on must get its own token type; falling back to IDENT silently disables it.
+cases=(
+  "LANGUAGE|LANGUAGE" "SCROLL|SCROLL" "MODE|MODE" "PROFILE|PROFILE" "USING|USING"
+  "WORK|WORK" "SIGIL|SIGIL" "TEXT|TEXT" "AS|AS" "UNUSED|UNUSED"
+  "THUS|THUS" "WE|WE" "ANSWER|ANSWER" "WITH|WITH" "ENDWORK|ENDWORK"
+  "SAY|SAY" "LET|LET" "BE|BE" "FROM|FROM"
+  "WEAVE|WEAVE" "ENDWEAVE|ENDWEAVE" "CHOIR|CHOIR" "ENDCHOIR|ENDCHOIR"
+  "AT|AT" "LEVEL|LEVEL" "ARCWORK|ARCWORK" "AND|AND" "OR|OR" "NOT|NOT"
+  "ALTAR|ALTAR" "ENDALTAR|ENDALTAR" "PORT|PORT" "ROUTE|ROUTE"
+  "GET|GET" "POST|POST" "PUT|PUT" "DELETE|DELETE" "HANDLER|HANDLER" "SERVICE|SERVICE"
+  "SEND|SEND" "BACK|BACK" "CHAMBER|CHAMBER" "ENDCHAMBER|ENDCHAMBER"
+  "ENTANGLE|ENTANGLE" "RELEASE|RELEASE" "CORE|CORE" "BIND|BIND"
+  "IF|IF" "ELSE|ELSE" "END|END" "ENDIF|END" "WHILE|WHILE" "ENDWHILE|ENDWHILE"
+  "EPHEMERAL|EPHEMERAL" "RAISE|RAISE" "OMEN|OMEN" "ENDOMEN|ENDOMEN"
+  "SUMMON|SUMMON" "YIELDS|YIELDS" "LOG|LOG" "SCRIBE|LOG"
+  "TIME_NOW|TIME_NOW" "SLEEP|SLEEP" "FOR|FOR" "SECONDS|SECONDS"
+  "INVISIBLE|INVISIBLE" "SEAL|SEAL" "SEALED|SEALED"
+  # Keywords are matched case-insensitively; plain names stay identifiers.
+  "say|SAY" "Let|LET" "whileish|IDENT"
+  # Backticks escape a keyword into a plain identifier.
+  "\`AND\`|IDENT" "\`GET\`|IDENT" "\`x_1\`|IDENT"
+  "\`\`|ILLEGAL" "\`a b\`|ILLEGAL" "\`AND|ILLEGAL"
+)
+
+for c in "${cases[@]}"; do
+  src="${c%%|*}"
+  want="${c#*|}"
+  printf '%s' "$src" > "$TMP/kw.sic"
+  got="$("$SIC" lex "$TMP/kw.sic" | awk 'NR==1 {print $1}')"
+  if [ "$got" = "$want" ]; then
+    echo "[OK] $src -> $got"
+  else
+    echo "[FAIL] $src -> $got (want $want)"
+    fail=1
+  fi
+done
+
+exit "$fail"
@@ -0,0 +1,64 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+# A known source: multi-byte text, an escaped string, an escaped name,
+# a two-character operator and a decimal on a second line.
+printf 'SAY: "h\\"é" + `AND`. // c\n  x1 >= 3.25\n' > "$TMP/o.sic"
+out="$("$SIC" lex --offsets "$TMP/o.sic")"
+
+# type | expected [offset,end)
+cases=(
+  "SAY|[0,3)"
+  "COLON|[3,4)"
+  "STRING|[5,12)"
+  "PLUS|[13,14)"
+  "IDENT|[15,20)"
+  "GTE|[32,34)"
+  "NUM|[35,39)"
+  "EOF|[40,40)"
+)
+for c in "${cases[@]}"; do
+  typ="${c%%|*}"
+  want="${c#*|}"
+  got="$(awk -v t="$typ" '$1 == t {print $NF; exit}' <<<"$out")"
+  if [ "$got" = "$want" ]; then
+    echo "[OK] $typ -> $got"
+  else
+    echo "[FAIL] $typ -> $got (want $want)"
+    fail=1
+  fi
+done
+
+# Spans slice back to the exact source text, delimiters included.
+slice() { head -c "$2" "$TMP/o.sic" | tail -c "+$(($1 + 1))"; }
+check_slice() {
+  got="$(slice "$1" "$2")"
+  if [ "$got" = "$3" ]; then
+    echo "[OK] source[$1:$2] = $got"
+  else
+    echo "[FAIL] source[$1:$2] = $got (want $3)"
+    fail=1
+  fi
+}
+check_slice 5 12 '"h\"é"'
+check_slice 15 20 '`AND`'
+check_slice 29 31 'x1'
+check_slice 32 34 '>='
+
+# Without --offsets the listing is unchanged.
+plain="$("$SIC" lex "$TMP/o.sic")"
+if [ "$(head -1 <<<"$plain")" = "SAY          \"SAY\"                ($TMP/o.sic:1:1)" ]; then
+  echo "[OK] default lex output has no offsets"
+else
+  echo "[FAIL] default lex output changed: $(head -1 <<<"$plain")"
+  fail=1
+fi
+
+exit "$fail"
@@ -0,0 +1,60 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+# source | expected token types, in order (EOF included)
+cases=(
+  "a == b|IDENT EQ IDENT EOF"
+  "a != b|IDENT NEQ IDENT EOF"
+  "a <= b|IDENT LTE IDENT EOF"
+  "a >= b|IDENT GTE IDENT EOF"
+  "a = b|IDENT EQUAL IDENT EOF"
+  "a < b|IDENT LT IDENT EOF"
+  "a > b|IDENT GT IDENT EOF"
+  "a<=b>=c|IDENT LTE IDENT GTE IDENT EOF"
+  "=|EQUAL EOF"
+  "<|LT EOF"
+  ">|GT EOF"
+  "!|NOT EOF"
+  "\$name|DOLLAR IDENT EOF"
+  "\$count + 1|DOLLAR IDENT PLUS NUM EOF"
+  "\$|DOLLAR EOF"
+  "7 % 2|NUM PERCENT NUM EOF"
+  "%|PERCENT EOF"
+  "2 + 3 * 4|NUM PLUS NUM STAR NUM EOF"
+  "42|NUM EOF"
+)
+
+for c in "${cases[@]}"; do
+  src="${c%%|*}"
+  want="${c#*|}"
+  # No trailing newline, so the last operator is followed directly by EOF.
+  printf '%s' "$src" > "$TMP/ops.sic"
+  got="$("$SIC" lex "$TMP/ops.sic" | awk '{print $1}' | tr '\n' ' ' | sed 's/ $//')"
+  if [ "$got" = "$want" ]; then
+    echo "[OK] '$src' -> $got"
+  else
+    echo "[FAIL] '$src' -> $got (want $want)"
+    fail=1
+  fi
+done
+
+# Operator tokens carry the position of their first character.
+printf 'LET SIGIL r BE\n  10 %% 3.' > "$TMP/pos.sic"
+# Capture first: grep -q exiting early would SIGPIPE sic under pipefail.
+out="$("$SIC" lex "$TMP/pos.sic")"
+if grep -qF "PERCENT      \"%\"                  ($TMP/pos.sic:2:6)" <<<"$out"; then
+  echo "[OK] % reports line 2, column 6"
+else
+  echo "[FAIL] % position:"
+  grep PERCENT <<<"$out"
+  fail=1
+fi
+
+exit "$fail"
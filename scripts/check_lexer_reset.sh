@@ -0,0 +1,41 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+if ! (cd "$ROOT" && go build -o "$TMP/harness" ./scripts/harness) 2>"$TMP/build.txt"; then
+  echo "[FAIL] harness build: $(head -1 "$TMP/build.txt")"
+  exit 1
+fi
+
+# A Lexer Reset onto a file, after lexing something else, gives the same
+# tokens and errors as a fresh one. The illegal characters and the
+# unterminated string and comment cover Reset clearing error state.
+cd "$ROOT"
+"$TMP/harness" lex-reset tests/test_lex_comments.sic tests/test_triple_string.sic \
+  tests/test_triple_string_unterminated_negative.sic tests/diag/errors.sic \
+  tests/lex/two_illegal.sic examples/weave_demo.sic >"$TMP/out" 2>&1
+rc=$?
+if [ "$rc" -eq 0 ]; then
+  echo "[OK] Reset matches a fresh Lexer ($(grep -c '^same:' "$TMP/out") runs)"
+else
+  echo "[FAIL] Reset differs from a fresh Lexer:"
+  cat "$TMP/out"
+  fail=1
+fi
+
+# The benchmark only has to run; its numbers are printed for reading.
+if "$TMP/harness" lex-bench examples/weave_demo.sic >"$TMP/bench" 2>&1; then
+  echo "[OK] lexer benchmark ran"
+  sed 's/^/  /' "$TMP/bench"
+else
+  echo "[FAIL] lexer benchmark:"
+  cat "$TMP/bench"
+  fail=1
+fi
+
+exit "$fail"
@@ -0,0 +1,40 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="$ROOT/tests/lint/crafted.sic"
+
+# rule flag | expected "line:col" of its single finding
+cases=(
+  "dots|12:8"
+  "bare-sigils|11:8"
+  "nesting|17:9"
+  "unreachable|24:3"
+  "magic-numbers|13:38"
+)
+
+fail=0
+for c in "${cases[@]}"; do
+  rule="${c%%|*}"
+  want="${c#*|}"
+  out="$("$SIC" lint "--$rule" "$F")"
+  got="$(echo "$out" | grep -c "\[$rule\]")"
+  if [ "$got" -eq 1 ] && [ "$(echo "$out" | wc -l)" -eq 1 ] && echo "$out" | grep -q ":$want: "; then
+    echo "[OK] --$rule -> $want"
+  else
+    echo "[FAIL] --$rule (want one finding at $want)"
+    echo "$out"
+    fail=1
+  fi
+done
+
+# --max-depth raises the nesting limit.
+if "$SIC" lint --nesting --max-depth 4 "$F" >/dev/null; then
+  echo "[OK] --nesting --max-depth 4 -> clean"
+else
+  echo "[FAIL] --nesting --max-depth 4 should be clean"
+  fail=1
+fi
+
+exit "$fail"
@@ -0,0 +1,31 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="tests/test_main_args.sic"
+
+fail=0
+check() {
+  local name="$1" want_rc="$2" want="$3" got rc
+  shift 3
+  got="$(cd "$ROOT" && "$SIC" run "$F" "$@" 2>&1)"
+  rc=$?
+  if [ "$rc" -eq "$want_rc" ] && [ "$got" = "$want" ]; then
+    echo "[OK] $name (exit $rc)"
+  else
+    echo "[FAIL] $name: exit $rc (want $want_rc)"
+    diff <(echo "$want") <(echo "$got")
+    fail=1
+  fi
+}
+
+check "two args bind name and age" 0 '[SIC SAY] name=Ada age=36
+[SIC SAY] ARG_0=Ada ARG_1=36' Ada 36
+
+check "an extra arg is only ARG_2" 0 '[SIC SAY] name=Ada age=36
+[SIC SAY] ARG_0=Ada ARG_1=36' Ada 36 extra
+
+check "a missing arg leaves age unset" 3 "[SIC] runtime error: unknown SIGIL age at $F:13:35" Ada
+
+exit "$fail"
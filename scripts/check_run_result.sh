@@ -0,0 +1,34 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+T="$ROOT/tests/embed"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+
+if ! (cd "$ROOT" && go build -o "$TMP/harness" ./scripts/harness) 2>"$TMP/build.txt"; then
+  echo "[FAIL] harness build: $(head -1 "$TMP/build.txt")"
+  exit 1
+fi
+
+check() {
+  local f="$1" want="$2" got
+  got="$("$TMP/harness" result "$T/$f" 2>&1)"
+  if [ "$got" = "$want" ]; then
+    echo "[OK] RunFileResult $f"
+  else
+    echo "[FAIL] RunFileResult $f:"
+    diff <(echo "$want") <(echo "$got")
+    fail=1
+  fi
+}
+
+# The answer is returned, not printed; SAY output still goes to stdout.
+check main_answers.sic '[SIC SAY] working
+answer: "forty-two"'
+check main_silent.sic '[SIC SAY] no answer here
+answer: ""'
+
+exit "$fail"
@@ -0,0 +1,35 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+T="$ROOT/tests"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+run() {
+  local name="$1" file="$2" want_rc="$3" want="$4" got rc
+  got="$("$SIC" run "$file" 2>&1)"
+  rc=$?
+  if [ "$rc" -eq "$want_rc" ] && [ "$got" = "$want" ]; then
+    echo "[OK] $name (exit $rc)"
+  else
+    echo "[FAIL] $name: exit $rc (want $want_rc)"
+    diff <(echo "$want") <(echo "$got")
+    fail=1
+  fi
+}
+
+# The same scroll passes as WEAK and fails as STRONG.
+cp "$T/test_scroll_weak.sic" "$TMP/weak.sic"
+sed 's/^SCROLL WEAK/SCROLL STRONG/' "$T/test_scroll_weak.sic" >"$TMP/strong.sic"
+
+run "WEAK warns and runs" "$TMP/weak.sic" 0 "parse warning: $TMP/weak.sic:6:1: unexpected IDENT at top level
+[SIC SAY] WEAK scroll ran."
+
+run "STRONG rejects the same scroll" "$TMP/strong.sic" 2 "parse error: $TMP/strong.sic:6:1: unexpected IDENT at top level (SCROLL STRONG)
+parse error: $TMP/strong.sic:11:13: SIGIL spare is bound but never read (SCROLL STRONG)
+[SIC] parse error: cannot run: parse failed"
+
+exit "$fail"
@@ -0,0 +1,26 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="$ROOT/tests/test_choir_serial.sic"
+
+# --serial-concurrency: CHOIR output is in source order on every run.
+want='[SIC SAY] task 1
+[SIC SAY] task 2
+[SIC SAY] task 3
+[SIC SAY] task 4
+[SIC SAY] bound: 0'
+
+fail=0
+for run in 1 2 3 4 5; do
+  got="$("$SIC" run --serial-concurrency "$F" 2>&1)"
+  if [ "$got" != "$want" ]; then
+    echo "[FAIL] run $run differs:"
+    diff <(echo "$want") <(echo "$got")
+    fail=1
+  fi
+done
+[ "$fail" -eq 0 ] && echo "[OK] --serial-concurrency: 5 identical runs in source order"
+
+exit "$fail"
@@ -0,0 +1,40 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+D="$ROOT/tests/state"
+DUMP="$D/roundtrip.json"
+
+fail=0
+rm -f "$DUMP"
+
+want='[SIC SAY] before ada, 7, 2.5, true, 3
+[SIC SAY] changed bob, 8, 0, false, 0
+[SIC SAY] after ada, 7, 2.5, true, 3
+[SIC SAY] caught state_denied'
+got="$("$SIC" run "$D/test_state_roundtrip.sic" 2>/dev/null)"
+if [ "$got" = "$want" ]; then
+  echo "[OK] DUMP STATE / LOAD STATE round-trip"
+else
+  echo "[FAIL] round-trip output differs:"
+  diff <(echo "$want") <(echo "$got")
+  fail=1
+fi
+
+# The dump keeps exact text and types, and never holds INVISIBLE sigils.
+if grep -q '"value": "007"' "$DUMP" && grep -q '"type": "bool"' "$DUMP"; then
+  echo "[OK] dump keeps exact values and types"
+else
+  echo "[FAIL] dump lost a value or type"
+  fail=1
+fi
+if grep -q 'secret\|hunter2' "$DUMP"; then
+  echo "[FAIL] dump contains an INVISIBLE sigil"
+  fail=1
+else
+  echo "[OK] INVISIBLE sigils are not dumped"
+fi
+
+rm -f "$DUMP"
+exit "$fail"
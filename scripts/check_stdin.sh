@@ -0,0 +1,50 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="$ROOT/tests/test_stdin_sigil.sic"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+expect() {
+  if [ "$2" = "$3" ]; then
+    echo "[OK] $1"
+  else
+    echo "[FAIL] $1:"
+    diff <(echo "$3") <(echo "$2")
+    fail=1
+  fi
+}
+
+want='[SIC SAY] stdin: HELLO WORLD
+[SIC SAY] length: 12
+[SIC SAY] truncated: false'
+expect "piped stdin becomes STDIN" "$(printf 'hello world\n' | "$SIC" run "$F" 2>&1)" "$want"
+
+printf 'hello world\n' > "$TMP/in.txt"
+expect "redirected file becomes STDIN" "$("$SIC" run "$F" <"$TMP/in.txt" 2>&1)" "$want"
+
+expect "/dev/null leaves STDIN unset" "$("$SIC" run "$F" </dev/null 2>&1)" "[SIC SAY] no piped stdin"
+
+# Input beyond the sigil size cap is cut off and flagged.
+want='[SIC SAY] length: 8192
+[SIC SAY] truncated: true'
+got="$(head -c 20000 /dev/zero | tr '\0' 'x' | "$SIC" run "$F" 2>&1 | tail -2)"
+expect "oversized stdin is truncated" "$got" "$want"
+
+# With `sic run -` stdin is the scroll itself, so STDIN stays unset.
+expect "sic run - does not bind STDIN" "$("$SIC" run - <"$F" 2>&1)" "[SIC SAY] no piped stdin"
+
+# A scroll that never names STDIN leaves stdin unread: the next reader
+# still gets the input, and a pipe that never closes does not block it.
+N="$ROOT/tests/test_stdin_unread.sic"
+got="$(printf 'left for cat\n' | { "$SIC" run "$N" 2>&1; cat; })"
+expect "unreferenced STDIN is not consumed" "$got" '[SIC SAY] ran without reading stdin
+left for cat'
+
+got="$( (sleep 3 | timeout 2 "$SIC" run "$N") 2>&1)"
+expect "an open pipe does not block it" "$got" '[SIC SAY] ran without reading stdin'
+
+exit "$fail"
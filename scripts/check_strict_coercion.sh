@@ -0,0 +1,57 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+T="$ROOT/tests"
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+fail=0
+check() {
+  if grep -qF -- "$3" "$2"; then
+    echo "[OK] $1"
+  else
+    echo "[FAIL] $1 (want: $3)"
+    cat "$2"
+    fail=1
+  fi
+}
+count() {
+  got="$(grep -c '\[SIC WARN\]' "$2")"
+  if [ "$got" -eq "$3" ]; then
+    echo "[OK] $1"
+  else
+    echo "[FAIL] $1: $got warning(s), want $3"
+    cat "$2"
+    fail=1
+  fi
+}
+
+# Without the flag a plain scroll coerces silently.
+"$SIC" run "$T/test_strict_coercion.sic" >"$TMP/out" 2>"$TMP/err"
+count "no warning by default" "$TMP/err" 0
+
+# --strict-coercion warns for "007" but not for a clean "7".
+"$SIC" run --strict-coercion "$T/test_strict_coercion.sic" >"$TMP/out" 2>"$TMP/err"
+check "flag warns for 007" "$TMP/err" 'SIGIL code holds "007", which is read as the number 7'
+count "only the lossy read warns" "$TMP/err" 1
+
+# The same scroll declared SCROLL STRONG fails on the lossy read, with or
+# without the flag.
+sed 's/^SCROLL test_strict_coercion/SCROLL STRONG test_strict_coercion/' \
+  "$T/test_strict_coercion.sic" >"$TMP/strong.sic"
+for flag in "" --strict-coercion; do
+  "$SIC" run $flag "$TMP/strong.sic" >"$TMP/out" 2>"$TMP/err"
+  rc=$?
+  if [ "$rc" -eq 3 ]; then
+    echo "[OK] STRONG scroll ${flag:+with $flag }exits 3"
+  else
+    echo "[FAIL] STRONG scroll ${flag:+with $flag }exited $rc (want 3)"
+    fail=1
+  fi
+  check "STRONG ${flag:+with $flag }names the lossy read" "$TMP/err" \
+    'SIGIL code holds "007", which is read as the number 7 at '"$TMP"'/strong.sic:15:6 (SCROLL STRONG)'
+done
+
+exit "$fail"
@@ -0,0 +1,37 @@
+#!/usr/bin/env bash
+set -uo pipefail
+
+ROOT="$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)"
+SIC="$ROOT/sic"
+F="$ROOT/tests/test_triple_string.sic"
+NEG="$ROOT/tests/test_triple_string_unterminated_negative.sic"
+
+fail=0
+check() {
+  if echo "$2" | grep -qF "$3"; then
+    echo "[OK] $1"
+  else
+    echo "[FAIL] $1 (want: $3)"
+    echo "$2"
+    fail=1
+  fi
+}
+
+out="$("$SIC" run "$F" 2>&1)"
+check "two-line string keeps its newline" "$out" "$(printf '[SIC SAY] <h1>Hello</h1>\n<p>two lines</p>')"
+check "escapes stay raw" "$out" '[SIC SAY] raw \n stays'
+check "lone quote inside" "$out" '[SIC SAY] quote " inside'
+check "empty triple-quoted string" "$out" "[SIC SAY] empty: []"
+
+# The string token starts at its opening quotes; lines inside it still count.
+out="$("$SIC" lex "$F")"
+check "token at opening quotes" "$out" "STRING       \"<h1>Hello</h1>\\n<p>two lines</p>\" ($F:16:21)"
+check "line tracking after the string" "$out" "SAY          \"SAY\"                ($F:18:3)"
+
+# An unterminated one is reported where it opens.
+out="$("$SIC" lex "$NEG")"
+check "unterminated -> ILLEGAL at opening" "$out" "ILLEGAL      \"\\\"\\\"\\\"\"             ($NEG:8:8)"
+out="$("$SIC" run "$NEG" 2>&1)"
+check "unterminated is a parse error" "$out" "$NEG:8:8: unterminated triple-quoted string"
+
+exit "$fail"
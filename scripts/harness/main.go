@@ -0,0 +1,162 @@
+// Command harness drives compiler APIs that the sic CLI does not expose,
+// for the scripts/check_*.sh checks. It is not installed or documented
+// for users.
+//
+//	go run ./scripts/harness result <file.sic>
+//	go run ./scripts/harness run [--no-body-cache] <file.sic>
+//	go run ./scripts/harness lex-reset <file.sic>...
+//	go run ./scripts/harness lex-bench <file.sic>
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/RobertP-SyndicateLabs/SIC-lang/compiler"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	switch os.Args[1] {
+	case "result":
+		if len(os.Args) != 3 {
+			usage()
+		}
+		runResult(os.Args[2])
+	case "run":
+		args := os.Args[2:]
+		if len(args) == 2 && args[0] == "--no-body-cache" {
+			compiler.SetWorkBodyCache(false)
+			args = args[1:]
+		}
+		if len(args) != 1 {
+			usage()
+		}
+		runScroll(args[0])
+	case "lex-reset":
+		if len(os.Args) < 3 {
+			usage()
+		}
+		runLexReset(os.Args[2:])
+	case "lex-bench":
+		if len(os.Args) != 3 {
+			usage()
+		}
+		runLexBench(os.Args[2])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: harness result <file.sic>")
+	fmt.Fprintln(os.Stderr, "       harness run [--no-body-cache] <file.sic>")
+	fmt.Fprintln(os.Stderr, "       harness lex-reset <file.sic>...")
+	fmt.Fprintln(os.Stderr, "       harness lex-bench <file.sic>")
+	os.Exit(1)
+}
+
+// runResult prints the value RunFileResult returns for MAIN's answer.
+func runResult(path string) {
+	answer, err := compiler.RunFileResult(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(3)
+	}
+	fmt.Printf("answer: %q\n", answer)
+}
+
+// runScroll runs path like sic run. With --no-body-cache every execution
+// re-cleans its WORK body, so the cached path can be compared against it.
+func runScroll(path string) {
+	if err := compiler.RunFile(path); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(3)
+	}
+}
+
+func readSource(path string) string {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+	return string(src)
+}
+
+// lexAll drains l, returning every token up to and including TOK_EOF.
+func lexAll(l *compiler.Lexer) []compiler.Token {
+	var toks []compiler.Token
+	for {
+		t := l.NextToken()
+		toks = append(toks, t)
+		if t.Type == compiler.TOK_EOF {
+			return toks
+		}
+	}
+}
+
+// runLexReset checks that a Lexer Reset onto each file, after lexing the
+// file before it, yields the same tokens and errors as a fresh Lexer.
+// With and without comments, so options surviving Reset are covered too.
+func runLexReset(paths []string) {
+	failed := false
+	for _, comments := range []bool{false, true} {
+		reused := compiler.NewLexer("", "")
+		reused.SetEmitComments(comments)
+		for _, path := range paths {
+			src := readSource(path)
+
+			fresh := compiler.NewLexer(src, path)
+			fresh.SetEmitComments(comments)
+			want, wantErrs := lexAll(fresh), fresh.Errors()
+
+			// Leave the reused lexer part-way through a different input.
+			reused.Reset(src+"\n\"unterminated @", "dirty.sic")
+			for k := 0; k < len(want)/2; k++ {
+				reused.NextToken()
+			}
+			reused.Reset(src, path)
+			got, gotErrs := lexAll(reused), reused.Errors()
+
+			if !reflect.DeepEqual(got, want) || !reflect.DeepEqual(gotErrs, wantErrs) {
+				fmt.Printf("differs: %s (comments=%v)\n", path, comments)
+				failed = true
+				continue
+			}
+			fmt.Printf("same: %s (comments=%v, %d tokens)\n", path, comments, len(want))
+		}
+	}
+	if failed {
+		os.Exit(3)
+	}
+}
+
+// runLexBench benchmarks lexing path with a fresh Lexer per pass against
+// one Lexer reused through Reset.
+func runLexBench(path string) {
+	src := readSource(path)
+
+	fresh := testing.Benchmark(func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			lexAll(compiler.NewLexer(src, path))
+		}
+	})
+	l := compiler.NewLexer("", "")
+	reset := testing.Benchmark(func(b *testing.B) {
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			l.Reset(src, path)
+			lexAll(l)
+		}
+	})
+
+	fmt.Printf("fresh: %s %s\n", fresh, fresh.MemString())
+	fmt.Printf("reset: %s %s\n", reset, reset.MemString())
+	fmt.Printf("allocs/op fresh=%d reset=%d\n", fresh.AllocsPerOp(), reset.AllocsPerOp())
+}